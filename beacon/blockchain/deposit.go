@@ -27,18 +27,33 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
 // defaultRetryInterval processes a deposit event.
 const defaultRetryInterval = 20 * time.Second
 
 func (s *Service[
-	_, _, ConsensusBlockT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, ConsensusBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) depositFetcher(
 	ctx context.Context,
+	st BeaconStateT,
 	blockNum math.U64,
 ) {
+	if s.depositFetcherRetired.Load() {
+		return
+	}
+	if s.legacyDepositsDrained(st) {
+		s.depositFetcherRetired.Store(true)
+		s.logger.Info(
+			"Legacy deposit queue drained, retiring deposit log fetcher",
+		)
+		return
+	}
+
 	if blockNum < s.eth1FollowDistance {
 		s.logger.Info(
 			"depositFetcher, nothing to fetch",
@@ -51,6 +66,32 @@ func (s *Service[
 	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
 }
 
+// legacyDepositsDrained returns true once all the deposits preceding the
+// first EIP-6110 deposit request have been processed. From then on deposits
+// are only delivered through the execution requests of the payload.
+func (s *Service[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+]) legacyDepositsDrained(st BeaconStateT) bool {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return false
+	}
+	if s.chainSpec.ActiveForkVersionForSlot(slot) < version.Electra {
+		return false
+	}
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil || startIndex == constants.UnsetDepositRequestsStartIndex {
+		return false
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return false
+	}
+	return core.NextLegacyDepositIndex(
+		s.chainSpec, slot, depositIndex,
+	) >= startIndex
+}
+
 func (s *Service[
 	_, _, ConsensusBlockT, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) fetchAndStoreDeposits(
@@ -101,6 +142,9 @@ func (s *Service[
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			if s.depositFetcherRetired.Load() {
+				return
+			}
 			s.failedBlocksMu.RLock()
 			failedBlks := slices.Collect(maps.Keys(s.failedBlocks))
 			s.failedBlocksMu.RUnlock()
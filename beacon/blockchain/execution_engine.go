@@ -102,6 +102,7 @@ func (s *Service[
 		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
+		s.signer.PublicKey(),
 	); err != nil {
 		s.logger.Error(
 			"failed to send forkchoice update with attributes in non-optimistic payload",
@@ -26,6 +26,7 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -57,6 +58,9 @@ func (s *Service[
 		//nolint:nilerr // If we don't have a block, we can't do anything.
 		return nil, nil
 	}
+	if blobs, err = s.fetchSidecars(ctx, blk, blobs); err != nil {
+		s.logger.Error("Failed to fetch gossiped blob sidecars", "error", err)
+	}
 
 	// STEP 2: Finalize sidecars first (block will check for
 	// sidecar availability)
@@ -93,7 +97,7 @@ func (s *Service[
 
 	// fetch and store the deposit for the block
 	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
-	s.depositFetcher(ctx, blockNum)
+	s.depositFetcher(ctx, st, blockNum)
 
 	// store the finalized block in the KVStore.
 	slot := blk.GetSlot()
@@ -156,6 +160,8 @@ func (s *Service[
 ) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
+	ctx, span := tracing.StartSpan(ctx, "blockchain.executeStateTransition")
+	defer span.End()
 	valUpdates, err := s.stateProcessor.Transition(
 		&transition.Context{
 			Context: ctx,
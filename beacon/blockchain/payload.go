@@ -130,6 +130,7 @@ func (s *Service[
 		// TODO: This is making an assumption about the consensus rules
 		// and possibly should be made more explicit later on.
 		lph.GetParentHash(),
+		s.signer.PublicKey(),
 	); err != nil {
 		s.metrics.markRebuildPayloadForRejectedBlockFailure(stateSlot, err)
 		return err
@@ -201,6 +202,7 @@ func (s *Service[
 		// parent hash was deemed valid by the state transition function we
 		// just processed.
 		payload.GetParentHash(),
+		s.signer.PublicKey(),
 	); err != nil {
 		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
 		return err
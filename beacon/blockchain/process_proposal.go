@@ -27,8 +27,10 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -71,6 +73,11 @@ func (s *Service[
 	if err != nil {
 		return createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
+	sidecars, err = s.fetchSidecars(ctx, blk, sidecars)
+	if err != nil {
+		s.logger.Error("Failed to fetch gossiped blob sidecars", "error", err)
+		return createProcessProposalResponse(errors.WrapNonFatal(err))
+	}
 
 	// Process the blob sidecars, if any
 	if !sidecars.IsNil() && sidecars.Len() > 0 {
@@ -89,7 +96,7 @@ func (s *Service[
 		](consensusSidecars)
 
 		// Verify the blobs and ensure they match the local state.
-		err = s.blobProcessor.VerifySidecars(c)
+		err = s.blobProcessor.VerifySidecars(ctx, c)
 		if err != nil {
 			s.logger.Error(
 				"rejecting incoming blob sidecars",
@@ -172,6 +179,11 @@ func (s *Service[
 		beaconBlk,
 		consensusTime,
 		proposerAddress)
+	if err == nil {
+		// Verify the payload gas limit against the parent one, so that the
+		// proposer cannot push gas limits around abruptly.
+		err = s.verifyGasLimit(preState, beaconBlk)
+	}
 	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
@@ -243,6 +255,8 @@ func (s *Service[
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
+	ctx, span := tracing.StartSpan(ctx, "blockchain.verifyStateRoot")
+	defer span.End()
 	_, err := s.stateProcessor.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
@@ -269,6 +283,25 @@ func (s *Service[
 	return err
 }
 
+// verifyGasLimit verifies that the gas limit of the incoming block payload is
+// within the bound allowed from the latest execution payload header.
+func (s *Service[
+	_, _, _, BeaconBlockT, _, BeaconStateT,
+	_, _, _, _, _, _, _, _, _,
+]) verifyGasLimit(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	return engineprimitives.VerifyGasLimit(
+		lph.GetGasLimit().Unwrap(),
+		blk.GetBody().GetExecutionPayload().GetGasLimit().Unwrap(),
+	)
+}
+
 // shouldBuildOptimisticPayloads returns true if optimistic
 // payload builds are enabled.
 func (s *Service[
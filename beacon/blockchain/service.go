@@ -23,6 +23,7 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/da/da"
 	"github.com/berachain/beacon-kit/execution/deposit"
@@ -30,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/node-api/backend"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -73,6 +75,8 @@ type Service[
 		AvailabilityStoreT,
 		ConsensusSidecarsT, BlobSidecarsT,
 	]
+	// sidecarFetcher retrieves sidecars missing from proposals, if set.
+	sidecarFetcher SidecarFetcher
 	// store is the block store for the service.
 	// TODO: Remove this and use the block store from the storage backend.
 	blockStore BlockStoreT
@@ -88,6 +92,10 @@ type Service[
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// depositFetcherRetired is set once all the deposits preceding the
+	// EIP-6110 deposit requests are processed, after which deposits are no
+	// longer read from the deposit contract logs.
+	depositFetcherRetired atomic.Bool
 	// logger is used for logging messages in the service.
 	logger log.Logger
 	// chainSpec holds the chain specifications.
@@ -98,6 +106,9 @@ type Service[
 	executionEngine ExecutionEngine[PayloadAttributesT]
 	// localBuilder is a local builder for constructing new beacon states.
 	localBuilder LocalBuilder[BeaconStateT]
+	// signer is the signer of the validator of the node, the proposer the
+	// payloads are built for ahead of time.
+	signer crypto.BLSSigner
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor[
 		BeaconBlockT,
@@ -149,6 +160,7 @@ func NewService[
 		AvailabilityStoreT,
 		ConsensusSidecarsT, BlobSidecarsT,
 	],
+	sidecarFetcher SidecarFetcher,
 	blockStore BlockStoreT,
 	depositStore deposit.Store[DepositT],
 	depositContract deposit.Contract[DepositT],
@@ -157,6 +169,7 @@ func NewService[
 	chainSpec common.ChainSpec,
 	executionEngine ExecutionEngine[PayloadAttributesT],
 	localBuilder LocalBuilder[BeaconStateT],
+	signer crypto.BLSSigner,
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
@@ -183,6 +196,7 @@ func NewService[
 		homeDir:                 homeDir,
 		storageBackend:          storageBackend,
 		blobProcessor:           blobProcessor,
+		sidecarFetcher:          sidecarFetcher,
 		blockStore:              blockStore,
 		depositStore:            depositStore,
 		depositContract:         depositContract,
@@ -192,6 +206,7 @@ func NewService[
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
+		signer:                  signer,
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// fetchSidecars returns the sidecars of blk. If the proposal carried none
+// although the block commits to blobs, they were gossiped outside of the
+// proposal and are fetched from peers.
+func (s *Service[
+	_, _, _, BeaconBlockT, _, _, _, _, _, _, _, _, _, BlobSidecarsT, _,
+]) fetchSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+) (BlobSidecarsT, error) {
+	if s.sidecarFetcher == nil ||
+		(!sidecars.IsNil() && sidecars.Len() > 0) ||
+		len(blk.GetBody().GetBlobKzgCommitments()) == 0 {
+		return sidecars, nil
+	}
+
+	bz, err := s.sidecarFetcher.Fetch(
+		ctx, blk.GetSlot().Unwrap(), blk.HashTreeRoot(),
+		s.sidecarVerifier(ctx, blk),
+	)
+	if err != nil {
+		return sidecars, err
+	}
+	sidecars = sidecars.Empty()
+	return sidecars, sidecars.UnmarshalSSZ(bz)
+}
+
+// sidecarVerifier returns a function checking that SSZ encoded sidecars are
+// the ones of blk, i.e. one per commitment, with valid inclusion proofs and
+// KZG proofs.
+func (s *Service[
+	_, _, _, BeaconBlockT, _, _, _, _, _, _, _, _,
+	ConsensusSidecarsT, BlobSidecarsT, _,
+]) sidecarVerifier(
+	ctx context.Context,
+	blk BeaconBlockT,
+) func([]byte) error {
+	return func(bz []byte) error {
+		var sidecars BlobSidecarsT
+		sidecars = sidecars.Empty()
+		if err := sidecars.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+		if n := len(blk.GetBody().GetBlobKzgCommitments()); sidecars.Len() != n {
+			return errors.Wrapf(
+				ErrDataNotAvailable, "got %d blob sidecars, expected %d",
+				sidecars.Len(), n,
+			)
+		}
+
+		var consensusSidecars *types.ConsensusSidecars[BlobSidecarsT]
+		consensusSidecars = consensusSidecars.New(sidecars, blk.GetHeader())
+		return s.blobProcessor.VerifySidecars(
+			ctx, convertConsensusSidecars[ConsensusSidecarsT](consensusSidecars),
+		)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -117,6 +118,8 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
 }
 
 // Genesis is the interface for the genesis.
@@ -142,6 +145,7 @@ type LocalBuilder[BeaconStateT any] interface {
 		parentBlockRoot common.Root,
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
+		proposer crypto.BLSPubkey,
 	) (*engineprimitives.PayloadID, error)
 	// SendForceHeadFCU sends a force head FCU request.
 	SendForceHeadFCU(
@@ -178,6 +182,11 @@ type ReadOnlyBeaconState[
 	)
 	// GetSlot retrieves the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
+	// GetEth1DepositIndex retrieves the latest processed deposit index.
+	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex retrieves the index of the first EIP-6110
+	// deposit request, unset until one is processed.
+	GetDepositRequestsStartIndex() (uint64, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
 }
@@ -212,6 +221,19 @@ type StateProcessor[
 	) (transition.ValidatorUpdates, error)
 }
 
+// SidecarFetcher retrieves sidecars that were gossiped outside of the
+// proposal.
+type SidecarFetcher interface {
+	// Fetch returns the SSZ encoded sidecars of the block with the given slot
+	// and root, once sidecars passing verify are received.
+	Fetch(
+		ctx context.Context,
+		slot uint64,
+		root common.Root,
+		verify func(sidecars []byte) error,
+	) ([]byte, error)
+}
+
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
 type StorageBackend[
@@ -30,8 +30,10 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -65,27 +67,36 @@ func (s *Service[
 		return nil, nil, err
 	}
 
+	// Act as the hosted validator CometBFT selected to propose.
+	signer, err := s.keyring.SignerByAddress(slotData.GetProposerAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
-	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot())
+	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot(), signer)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Create a new empty block from the current state.
-	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
+	blk, err := s.getEmptyBeaconBlockForSlot(
+		st, slotData.GetSlot(), signer.PublicKey(),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk, slotData)
+	payloadCtx, span := tracing.StartSpan(ctx, "validator.retrievePayload")
+	envelope, err := s.retrieveExecutionPayload(
+		payloadCtx, st, blk, slotData, signer.PublicKey(),
+	)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, err
 	}
-	if envelope == nil {
-		return nil, nil, ErrNilPayload
-	}
 
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
@@ -107,8 +118,10 @@ func (s *Service[
 	}
 
 	// Produce blob sidecars with new StateRoot
+	_, span = tracing.StartSpan(ctx, "validator.buildSidecars")
 	sidecars, err := s.blobFactory.BuildSidecars(
 		blk, envelope.GetBlobsBundle())
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,6 +142,17 @@ func (s *Service[
 		return nil, nil, scErr
 	}
 
+	// When gossiping sidecars, the proposal only carries the commitments and
+	// peers fetch the sidecars from the sidecar channel instead.
+	if s.sidecarPublisher != nil && sidecars.Len() > 0 {
+		s.sidecarPublisher.Publish(
+			blk.GetSlot().Unwrap(), blk.HashTreeRoot(), sidecarsBytes,
+		)
+		if sidecarsBytes, scErr = sidecars.Empty().MarshalSSZ(); scErr != nil {
+			return nil, nil, scErr
+		}
+	}
+
 	return blkBytes, sidecarsBytes, nil
 }
 
@@ -136,7 +160,7 @@ func (s *Service[
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
-	st BeaconStateT, requestedSlot math.Slot,
+	st BeaconStateT, requestedSlot math.Slot, proposer crypto.BLSPubkey,
 ) (BeaconBlockT, error) {
 	var blk BeaconBlockT
 	// Create a new block.
@@ -149,9 +173,7 @@ func (s *Service[
 	}
 
 	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
-		s.signer.PublicKey(),
-	)
+	proposerIndex, err := st.ValidatorIndexByPubkey(proposer)
 	if err != nil {
 		return blk, err
 	}
@@ -164,12 +186,14 @@ func (s *Service[
 	)
 }
 
-// buildRandaoReveal builds a randao reveal for the given slot.
+// buildRandaoReveal builds the randao reveal of the proposer for the given
+// slot.
 func (s *Service[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
+	signer crypto.BLSSigner,
 ) (crypto.BLSSignature, error) {
 	var (
 		forkData ForkDataT
@@ -181,18 +205,42 @@ func (s *Service[
 		return crypto.BLSSignature{}, err
 	}
 
+	forkVersion := version.FromUint32[common.Version](
+		s.chainSpec.ActiveForkVersionForEpoch(epoch),
+	)
 	signingRoot := forkData.New(
-		version.FromUint32[common.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+		forkVersion, genesisValidatorsRoot,
 	).ComputeRandaoSigningRoot(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
 	)
-	return s.signer.Sign(signingRoot[:])
+
+	// Remote signers and slashing protection need to know what they sign.
+	if revealSigner, ok := signer.(crypto.RandaoRevealSigner); ok {
+		// The state has been processed up to slot, so its fork is the one
+		// active at epoch.
+		fork, fErr := st.GetFork()
+		if fErr != nil {
+			return crypto.BLSSignature{}, fErr
+		}
+		return revealSigner.SignRandaoReveal(
+			crypto.ForkInfo{
+				PreviousVersion:       bytes.B4(fork.PreviousVersion),
+				CurrentVersion:        bytes.B4(fork.CurrentVersion),
+				Epoch:                 fork.Epoch.Unwrap(),
+				GenesisValidatorsRoot: bytes.B32(genesisValidatorsRoot),
+			},
+			slot.Unwrap(),
+			epoch.Unwrap(),
+			bytes.B32(signingRoot),
+		)
+	}
+	return signer.Sign(signingRoot[:])
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
+// A payload built ahead of time whose gas limit is out of bounds is rejected
+// and rebuilt.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, BeaconStateT, _, _, _, _,
 	ExecutionPayloadT, ExecutionPayloadHeaderT, _, SlashingInfoT, SlotDataT,
@@ -201,6 +249,7 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 	slotData types.SlotData[ctypes.AttestationData, ctypes.SlashingInfo],
+	proposer crypto.BLSPubkey,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	//
 	// TODO: Add external block builders to this flow.
@@ -211,9 +260,13 @@ func (s *Service[
 			ctx,
 			blk.GetSlot(),
 			blk.GetParentBlockRoot(),
+			proposer,
 		)
 	if err == nil {
-		return envelope, nil
+		err = s.verifyPayloadGasLimit(st, envelope, proposer)
+		if err == nil {
+			return envelope, nil
+		}
 	}
 
 	// If we failed to retrieve the payload, request a synchronous payload.
@@ -238,7 +291,7 @@ func (s *Service[
 		return nil, err
 	}
 
-	return s.localPayloadBuilder.RequestPayloadSync(
+	envelope, err = s.localPayloadBuilder.RequestPayloadSync(
 		ctx,
 		st,
 		blk.GetSlot(),
@@ -250,7 +303,61 @@ func (s *Service[
 		blk.GetParentBlockRoot(),
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
+		proposer,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return envelope, s.verifyPayloadGasLimit(st, envelope, proposer)
+}
+
+// verifyPayloadGasLimit ensures that the gas limit of the payload is within
+// the bound allowed from the latest execution payload header. A payload that
+// does not move towards the target gas limit of the proposer is still
+// proposed, as the gas limit is chosen by the execution client, but the
+// mismatch is reported.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+]) verifyPayloadGasLimit(
+	st BeaconStateT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	proposer crypto.BLSPubkey,
+) error {
+	if envelope == nil || envelope.GetExecutionPayload().IsNil() {
+		return ErrNilPayload
+	}
+	payload := envelope.GetExecutionPayload()
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+
+	var (
+		parentGasLimit = lph.GetGasLimit().Unwrap()
+		gasLimit       = payload.GetGasLimit().Unwrap()
 	)
+	if err = engineprimitives.VerifyGasLimit(
+		parentGasLimit, gasLimit,
+	); err != nil {
+		return err
+	}
+
+	target := s.registrations.GasLimit(proposer)
+	if target == 0 {
+		return nil
+	}
+	expected := engineprimitives.CalcGasLimit(parentGasLimit, target)
+	if gasLimit != expected {
+		s.logger.Warn(
+			"Payload gas limit does not move towards target gas limit - "+
+				"please check your EL gas limit configuration",
+			"payload_gas_limit", gasLimit,
+			"expected_gas_limit", expected,
+			"target_gas_limit", target,
+		)
+	}
+	return nil
 }
 
 // BuildBlockBody assembles the block body with necessary components.
@@ -283,6 +390,27 @@ func (s *Service[
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
+	// Get the epoch to find the active fork version.
+	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
+	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
+		epoch,
+	)
+
+	// From Electra, set the execution requests returned alongside the payload.
+	var (
+		executionRequests *ctypes.ExecutionRequests
+		err               error
+	)
+	if activeForkVersion >= version.Electra {
+		executionRequests, err = ctypes.DecodeExecutionRequests(
+			envelope.GetExecutionRequests(),
+		)
+		if err != nil {
+			return err
+		}
+		body.SetExecutionRequests(executionRequests)
+	}
+
 	// Dequeue deposits from the state.
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
@@ -304,6 +432,22 @@ func (s *Service[
 		return err
 	}
 
+	// From Electra, deposits are delivered through the deposit requests, so
+	// only the legacy deposits preceding them are still included. Deposits
+	// from the store are contiguous starting at depositIndex.
+	if executionRequests != nil {
+		var limit uint64
+		limit, err = legacyDepositsLimit(st, executionRequests)
+		if err != nil {
+			return err
+		}
+		if limit <= depositIndex {
+			deposits = deposits[:0]
+		} else if limit-depositIndex < uint64(len(deposits)) {
+			deposits = deposits[:limit-depositIndex]
+		}
+	}
+
 	// Set the deposits on the block body.
 	s.logger.Info(
 		"Building block body with local deposits",
@@ -327,12 +471,7 @@ func (s *Service[
 	}
 	body.SetGraffiti(graffiti)
 
-	// Get the epoch to find the active fork version.
-	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
-	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
-		epoch,
-	)
-	if activeForkVersion >= version.DenebPlus {
+	if activeForkVersion == version.DenebPlus {
 		// Set the attestations on the block body.
 		// TODO: Remove conversion once generics have been replaced with
 		// concrete types.
@@ -354,6 +493,23 @@ func (s *Service[
 	return nil
 }
 
+// legacyDepositsLimit returns the first deposit index which is delivered
+// through the deposit requests rather than the legacy deposits of the body.
+func legacyDepositsLimit(
+	st interface{ GetDepositRequestsStartIndex() (uint64, error) },
+	executionRequests *ctypes.ExecutionRequests,
+) (uint64, error) {
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return 0, err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex &&
+		len(executionRequests.Deposits) > 0 {
+		startIndex = executionRequests.Deposits[0].GetIndex().Unwrap()
+	}
+	return startIndex, nil
+}
+
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
@@ -396,6 +552,8 @@ func (s *Service[
 ) (common.Root, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
+	ctx, span := tracing.StartSpan(ctx, "validator.computeStateRoot")
+	defer span.End()
 	if _, err := s.stateProcessor.Transition(
 		// TODO: We should think about how having optimistic
 		// engine enabled here would affect the proposer when
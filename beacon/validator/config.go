@@ -27,6 +27,10 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultTargetGasLimit is the default target gas limit, leaving the gas
+	// limit to the execution client.
+	defaultTargetGasLimit = 0
 )
 
 // Config is the validator configuration.
@@ -37,6 +41,11 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// TargetGasLimit is the gas limit the payloads built for the validators
+	// of this node should move towards, unless they registered their own.
+	// Zero leaves the gas limit to the execution client.
+	TargetGasLimit uint64 `mapstructure:"target-gas-limit"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -44,5 +53,6 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		TargetGasLimit:                defaultTargetGasLimit,
 	}
 }
@@ -25,7 +25,6 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
-	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
@@ -41,7 +40,7 @@ type Service[
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -53,8 +52,8 @@ type Service[
 	logger log.Logger
 	// chainSpec is the chain spec.
 	chainSpec common.ChainSpec
-	// signer is used to retrieve the public key of this node.
-	signer crypto.BLSSigner
+	// keyring holds the signers of the validators hosted by this node.
+	keyring Keyring
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT]
 	// sb is the beacon state backend.
@@ -74,6 +73,11 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// registrations holds the payload preferences of the validators, which
+	// the payloads are checked against.
+	registrations Registrations
+	// sidecarPublisher gossips sidecars outside of the proposal, if set.
+	sidecarPublisher SidecarPublisher
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -90,7 +94,7 @@ func NewService[
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -106,10 +110,12 @@ func NewService[
 		*transition.Context,
 		ExecutionPayloadHeaderT,
 	],
-	signer crypto.BLSSigner,
+	keyring Keyring,
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	registrations Registrations,
+	sidecarPublisher SidecarPublisher,
 	ts TelemetrySink,
 ) *Service[
 	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -127,11 +133,13 @@ func NewService[
 		logger:                logger,
 		sb:                    sb,
 		chainSpec:             chainSpec,
-		signer:                signer,
+		keyring:               keyring,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		registrations:         registrations,
+		sidecarPublisher:      sidecarPublisher,
 		metrics:               newValidatorMetrics(ts),
 	}
 }
@@ -40,7 +40,7 @@ type BeaconBlock[
 	T any,
 	BeaconBlockBodyT any,
 ] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// NewWithVersion creates a new beacon block with the given parameters.
 	NewWithVersion(
 		slot math.Slot,
@@ -83,6 +83,9 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetExecutionRequests sets the execution requests of the beacon block
+	// body.
+	SetExecutionRequests(*ctypes.ExecutionRequests)
 }
 
 // BeaconState represents a beacon state interface.
@@ -103,8 +106,13 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex returns the index of the first EIP-6110
+	// deposit request, unset until one is processed.
+	GetDepositRequestsStartIndex() (uint64, error)
 	// GetGenesisValidatorsRoot returns the genesis validators root.
 	GetGenesisValidatorsRoot() (common.Root, error)
+	// GetFork returns the fork of the beacon state.
+	GetFork() (*ctypes.Fork, error)
 }
 
 // BlobFactory represents a blob factory interface.
@@ -136,6 +144,16 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() common.ExecutionHash
+	// GetGasLimit returns the gas limit of the execution payload header.
+	GetGasLimit() math.U64
+}
+
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload interface {
+	// IsNil checks if the execution payload is nil.
+	IsNil() bool
+	// GetGasLimit returns the gas limit of the execution payload.
+	GetGasLimit() math.U64
 }
 
 // ForkData represents the fork data interface.
@@ -160,6 +178,7 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		ctx context.Context,
 		slot math.Slot,
 		parentBlockRoot common.Root,
+		proposer crypto.BLSPubkey,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	// RequestPayloadSync requests a payload for the given slot and
 	// blocks until the payload is delivered.
@@ -171,9 +190,24 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		parentBlockRoot common.Root,
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
+		proposer crypto.BLSPubkey,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// Keyring holds the signers of the validators hosted by the node.
+type Keyring interface {
+	// SignerByAddress returns the signer of the hosted validator with the
+	// given CometBFT address.
+	SignerByAddress(address []byte) (crypto.BLSSigner, error)
+}
+
+// Registrations provides the payload preferences registered by validators.
+type Registrations interface {
+	// GasLimit returns the target gas limit registered for the validator with
+	// the given pubkey, zero if it has no preference.
+	GasLimit(pubkey crypto.BLSPubkey) uint64
+}
+
 // SlotData represents the slot data interface.
 type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -220,6 +254,13 @@ type StorageBackend[
 	StateFromContext(context.Context) BeaconStateT
 }
 
+// SidecarPublisher gossips the sidecars of a block outside of the proposal.
+type SidecarPublisher interface {
+	// Publish gossips the SSZ encoded sidecars of the block with the given
+	// slot and root.
+	Publish(slot uint64, root common.Root, sidecars []byte)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
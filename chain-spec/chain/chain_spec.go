@@ -38,6 +38,11 @@ type Spec[
 	// calculations in Gwei.
 	MaxEffectiveBalance(isPostUpgrade bool) uint64
 
+	// MaxEffectiveBalanceCompounding returns the maximum balance counted in
+	// rewards calculations in Gwei for validators with compounding withdrawal
+	// credentials.
+	MaxEffectiveBalanceCompounding() uint64
+
 	// EjectionBalance returns the balance below which a validator is ejected.
 	EjectionBalance() uint64
 
@@ -138,6 +143,20 @@ type Spec[
 	// registry.
 	ValidatorRegistryLimit() uint64
 
+	// Validator cycle
+
+	// MinPerEpochChurnLimit returns the minimum number of validators which
+	// can be activated or exited per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the quotient scaling the churn limit with
+	// the number of active validators.
+	ChurnLimitQuotient() uint64
+
+	// MaxPerEpochActivationChurnLimit returns the maximum number of
+	// validators which can be activated per epoch.
+	MaxPerEpochActivationChurnLimit() uint64
+
 	// Rewards and Penalties
 
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
@@ -204,13 +223,14 @@ type Spec[
 	ValidatorSetCap() uint64
 
 	// EVMInflationAddress returns the address on the EVM which will receive
-	// the inflation amount of native EVM balance through a withdrawal every
-	// block.
-	EVMInflationAddress() ExecutionAddressT
+	// the inflation amount of native EVM balance through a withdrawal at the
+	// given slot, according to the EVM inflation schedule.
+	EVMInflationAddress(slot SlotT) ExecutionAddressT
 
 	// EVMInflationPerBlock returns the amount of native EVM balance (in Gwei)
-	// to be minted to the EVMInflationAddress via a withdrawal every block.
-	EVMInflationPerBlock() uint64
+	// to be minted to the EVMInflationAddress via a withdrawal at the given
+	// slot, according to the EVM inflation schedule.
+	EVMInflationPerBlock(slot SlotT) uint64
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -258,7 +278,20 @@ func (c *chainSpec[
 		return ErrInvalidValidatorSetCap
 	}
 
-	// EVM Inflation values can be zero or non-zero, no validation needed.
+	if c.MaxEffectiveBalanceCompounding() < c.MaxEffectiveBalance(true) {
+		return ErrInvalidMaxEffectiveBalanceCompounding
+	}
+
+	if c.MinPerEpochChurnLimit() == 0 || c.ChurnLimitQuotient() == 0 ||
+		c.MaxPerEpochActivationChurnLimit() == 0 {
+		return ErrInvalidChurnLimit
+	}
+
+	// EVM Inflation values can be zero or non-zero, only the schedule shape
+	// needs validation.
+	if err := c.validateEVMInflationSchedule(); err != nil {
+		return err
+	}
 
 	// TODO: Add more validation rules here.
 	return nil
@@ -282,6 +315,14 @@ func (c chainSpec[
 	return c.Data.MaxEffectiveBalancePreUpgrade
 }
 
+// MaxEffectiveBalanceCompounding returns the maximum effective balance of
+// validators with compounding withdrawal credentials.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxEffectiveBalanceCompounding() uint64 {
+	return c.Data.MaxEffectiveBalanceCompounding
+}
+
 // EjectionBalance returns the balance below which a validator is ejected.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -471,6 +512,30 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// MinPerEpochChurnLimit returns the minimum number of validators which can be
+// activated or exited per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient scaling the churn limit with the
+// number of active validators.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// MaxPerEpochActivationChurnLimit returns the maximum number of validators
+// which can be activated per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPerEpochActivationChurnLimit() uint64 {
+	return c.Data.MaxPerEpochActivationChurnLimit
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -557,19 +622,3 @@ func (c chainSpec[
 ]) ValidatorSetCap() uint64 {
 	return c.Data.ValidatorSetCap
 }
-
-// EVMInflationAddress returns the address on the EVM which will receive the
-// inflation amount of native EVM balance through a withdrawal every block.
-func (c chainSpec[
-	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) EVMInflationAddress() ExecutionAddressT {
-	return c.Data.EVMInflationAddress
-}
-
-// EVMInflationPerBlock returns the amount of native EVM balance (in Gwei) to
-// be minted to the EVMInflationAddress via a withdrawal every block.
-func (c chainSpec[
-	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) EVMInflationPerBlock() uint64 {
-	return c.Data.EVMInflationPerBlock
-}
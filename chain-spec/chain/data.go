@@ -39,6 +39,9 @@ type SpecData[
 	// MaxEffectiveBalancePostUpgrade is the maximum effective balance allowed
 	// for a validator after the upgrade.
 	MaxEffectiveBalancePostUpgrade uint64 `mapstructure:"max-effective-balance-post-upgrade"`
+	// MaxEffectiveBalanceCompounding is the maximum effective balance allowed
+	// for a validator with compounding withdrawal credentials.
+	MaxEffectiveBalanceCompounding uint64 `mapstructure:"max-effective-balance-compounding"`
 	// EjectionBalance is the balance at which a validator is ejected.
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
@@ -119,6 +122,18 @@ type SpecData[
 	// registry.
 	ValidatorRegistryLimit uint64 `mapstructure:"validator-registry-limit"`
 
+	// Validator cycle constants, enforced from Electra.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators which can
+	// be activated or exited per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient scales the churn limit with the number of active
+	// validators.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// MaxPerEpochActivationChurnLimit is the maximum number of validators
+	// which can be activated per epoch.
+	MaxPerEpochActivationChurnLimit uint64 `mapstructure:"max-per-epoch-activation-churn-limit"`
+
 	// Rewards and penalties constants.
 	//
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
@@ -174,4 +189,10 @@ type SpecData[
 	// EVMInflationPerBlock is the amount of native EVM balance (in Gwei) to be
 	// minted to the EVMInflationAddress via a withdrawal every block.
 	EVMInflationPerBlock uint64 `mapstructure:"evm-inflation-per-block"`
+	// EVMInflationSchedule is the piecewise schedule of EVM inflation
+	// periods, sorted by start epoch. When empty, EVMInflationAddress and
+	// EVMInflationPerBlock apply from genesis onwards.
+	EVMInflationSchedule []EVMInflationPeriod[
+		EpochT, ExecutionAddressT,
+	] `mapstructure:"evm-inflation-schedule"`
 }
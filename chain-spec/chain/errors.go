@@ -35,4 +35,30 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrInvalidMaxEffectiveBalanceCompounding is returned when the max
+	// effective balance of compounding validators is lower than the one of
+	// non compounding validators.
+	ErrInvalidMaxEffectiveBalanceCompounding = errors.New(
+		"max effective balance compounding must not be less than max effective balance",
+	)
+
+	// ErrInvalidChurnLimit is returned when any of the churn limit values is
+	// zero, which would stall validators activations or exits.
+	ErrInvalidChurnLimit = errors.New(
+		"churn limit values must be greater than zero",
+	)
+
+	// ErrInvalidEVMInflationSchedule is returned when the EVM inflation
+	// schedule does not start at genesis or its periods are not sorted by
+	// strictly increasing start epoch.
+	ErrInvalidEVMInflationSchedule = errors.New(
+		"evm inflation schedule must start at epoch 0 and be strictly sorted",
+	)
+
+	// ErrInvalidEVMInflationDecay is returned when an EVM inflation period
+	// has a zero decay denominator or a decay factor greater than one.
+	ErrInvalidEVMInflationDecay = errors.New(
+		"evm inflation decay factor must be a ratio not greater than one",
+	)
 )
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import "math/big"
+
+// EVMInflationPeriod is a single period of the EVM inflation schedule. The
+// period applies from StartEpoch until the StartEpoch of the next period in
+// the schedule, if any.
+type EVMInflationPeriod[
+	EpochT ~uint64,
+	ExecutionAddressT ~[20]byte,
+] struct {
+	// StartEpoch is the first epoch at which the period applies.
+	StartEpoch EpochT `mapstructure:"start-epoch"`
+	// Address is the address on the EVM which receives the inflation
+	// withdrawal during the period.
+	Address ExecutionAddressT `mapstructure:"address"`
+	// PerBlock is the amount of native EVM balance (in Gwei) minted every
+	// block at the start of the period.
+	PerBlock uint64 `mapstructure:"per-block"`
+	// DecayEpochs is the number of epochs after which the per block amount
+	// is scaled by DecayNumerator / DecayDenominator. Zero disables decay.
+	DecayEpochs uint64 `mapstructure:"decay-epochs"`
+	// DecayNumerator is the numerator of the decay factor.
+	DecayNumerator uint64 `mapstructure:"decay-numerator"`
+	// DecayDenominator is the denominator of the decay factor.
+	DecayDenominator uint64 `mapstructure:"decay-denominator"`
+}
+
+// decayPrecision is the number of fractional bits of the fixed point decay
+// factor used by perBlockAt.
+const decayPrecision = 128
+
+// perBlockAt returns the amount minted per block at the given epoch, which
+// must not precede the start of the period. The decay factor is raised to
+// the number of elapsed decay steps by squaring, in fixed point with
+// decayPrecision fractional bits, and the result is rounded down.
+func (p EVMInflationPeriod[EpochT, ExecutionAddressT]) perBlockAt(
+	epoch EpochT,
+) uint64 {
+	if p.DecayEpochs == 0 || epoch < p.StartEpoch {
+		return p.PerBlock
+	}
+
+	steps := uint64(epoch-p.StartEpoch) / p.DecayEpochs
+	factor := new(big.Int).SetUint64(p.DecayNumerator)
+	factor.Lsh(factor, decayPrecision)
+	factor.Quo(factor, new(big.Int).SetUint64(p.DecayDenominator))
+	scale := new(big.Int).Lsh(big.NewInt(1), decayPrecision)
+	for ; steps > 0 && scale.Sign() > 0; steps >>= 1 {
+		if steps&1 == 1 {
+			scale.Mul(scale, factor).Rsh(scale, decayPrecision)
+		}
+		factor.Mul(factor, factor).Rsh(factor, decayPrecision)
+	}
+
+	// DecayNumerator <= DecayDenominator is enforced on validation, so the
+	// scale never exceeds one and the amount always fits in 64 bits.
+	amount := new(big.Int).SetUint64(p.PerBlock)
+	return amount.Mul(amount, scale).Rsh(amount, decayPrecision).Uint64()
+}
+
+// validate ensures the period decay parameters are consistent.
+func (p EVMInflationPeriod[EpochT, ExecutionAddressT]) validate() error {
+	if p.DecayEpochs == 0 {
+		return nil
+	}
+	if p.DecayDenominator == 0 || p.DecayNumerator > p.DecayDenominator {
+		return ErrInvalidEVMInflationDecay
+	}
+	return nil
+}
+
+// evmInflationSchedule returns the EVM inflation schedule. When no schedule
+// is configured, a single period starting at genesis built from the
+// EVMInflationAddress and EVMInflationPerBlock values is used.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) evmInflationSchedule() []EVMInflationPeriod[EpochT, ExecutionAddressT] {
+	if len(c.Data.EVMInflationSchedule) > 0 {
+		return c.Data.EVMInflationSchedule
+	}
+	return []EVMInflationPeriod[EpochT, ExecutionAddressT]{{
+		Address:  c.Data.EVMInflationAddress,
+		PerBlock: c.Data.EVMInflationPerBlock,
+	}}
+}
+
+// evmInflationPeriod returns the EVM inflation period active at the given
+// epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) evmInflationPeriod(
+	epoch EpochT,
+) EVMInflationPeriod[EpochT, ExecutionAddressT] {
+	schedule := c.evmInflationSchedule()
+	period := schedule[0]
+	for _, p := range schedule[1:] {
+		if p.StartEpoch > epoch {
+			break
+		}
+		period = p
+	}
+	return period
+}
+
+// validateEVMInflationSchedule ensures the configured EVM inflation schedule
+// starts at genesis, is sorted by strictly increasing start epochs and has
+// consistent decay parameters.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) validateEVMInflationSchedule() error {
+	schedule := c.evmInflationSchedule()
+	if schedule[0].StartEpoch != 0 {
+		return ErrInvalidEVMInflationSchedule
+	}
+	for i, p := range schedule {
+		if i > 0 && p.StartEpoch <= schedule[i-1].StartEpoch {
+			return ErrInvalidEVMInflationSchedule
+		}
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EVMInflationAddress returns the address on the EVM which will receive the
+// inflation amount of native EVM balance through a withdrawal at the given
+// slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EVMInflationAddress(slot SlotT) ExecutionAddressT {
+	return c.evmInflationPeriod(c.SlotToEpoch(slot)).Address
+}
+
+// EVMInflationPerBlock returns the amount of native EVM balance (in Gwei) to
+// be minted to the EVMInflationAddress via a withdrawal at the given slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EVMInflationPerBlock(slot SlotT) uint64 {
+	epoch := c.SlotToEpoch(slot)
+	return c.evmInflationPeriod(epoch).perBlockAt(epoch)
+}
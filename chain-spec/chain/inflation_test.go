@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpecData = chain.SpecData[
+	domainType, epoch, executionAddress, slot, cometBFTConfig,
+]
+
+type testInflationPeriod = chain.EVMInflationPeriod[epoch, executionAddress]
+
+// inflationSpecData returns a valid spec data with the given EVM inflation
+// schedule.
+func inflationSpecData(schedule ...testInflationPeriod) testSpecData {
+	return testSpecData{
+		SlotsPerEpoch:                   32,
+		MaxWithdrawalsPerPayload:        2,
+		MinPerEpochChurnLimit:           4,
+		ChurnLimitQuotient:              65536,
+		MaxPerEpochActivationChurnLimit: 8,
+		EVMInflationAddress:             executionAddress{0x01},
+		EVMInflationPerBlock:            10e9,
+		EVMInflationSchedule:            schedule,
+	}
+}
+
+func TestEVMInflationDefaultSchedule(t *testing.T) {
+	cs, err := chain.NewChainSpec(inflationSpecData())
+	require.NoError(t, err)
+
+	for _, s := range []slot{0, 31, 32, 1_000_000} {
+		require.Equal(t, executionAddress{0x01}, cs.EVMInflationAddress(s))
+		require.Equal(t, uint64(10e9), cs.EVMInflationPerBlock(s))
+	}
+}
+
+func TestEVMInflationPiecewiseSchedule(t *testing.T) {
+	cs, err := chain.NewChainSpec(inflationSpecData(
+		testInflationPeriod{
+			StartEpoch: 0,
+			Address:    executionAddress{0x01},
+			PerBlock:   10e9,
+		},
+		testInflationPeriod{
+			StartEpoch: 10,
+			Address:    executionAddress{0x02},
+			PerBlock:   5e9,
+		},
+		testInflationPeriod{
+			StartEpoch:       20,
+			Address:          executionAddress{0x03},
+			PerBlock:         8e9,
+			DecayEpochs:      5,
+			DecayNumerator:   1,
+			DecayDenominator: 2,
+		},
+	))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		slot     slot
+		address  executionAddress
+		perBlock uint64
+	}{
+		{"genesis", 0, executionAddress{0x01}, 10e9},
+		{"end of first period", 10*32 - 1, executionAddress{0x01}, 10e9},
+		{"start of second period", 10 * 32, executionAddress{0x02}, 5e9},
+		{"start of decaying period", 20 * 32, executionAddress{0x03}, 8e9},
+		{"before first decay", 25*32 - 1, executionAddress{0x03}, 8e9},
+		{"first decay", 25 * 32, executionAddress{0x03}, 4e9},
+		{"third decay", 35 * 32, executionAddress{0x03}, 1e9},
+		{"fully decayed", 1_000 * 32, executionAddress{0x03}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.address, cs.EVMInflationAddress(tt.slot))
+			require.Equal(t, tt.perBlock, cs.EVMInflationPerBlock(tt.slot))
+		})
+	}
+}
+
+func TestEVMInflationDecayManySteps(t *testing.T) {
+	cs, err := chain.NewChainSpec(inflationSpecData(
+		testInflationPeriod{
+			StartEpoch:       0,
+			Address:          executionAddress{0x01},
+			PerBlock:         8e9,
+			DecayEpochs:      1,
+			DecayNumerator:   999_999,
+			DecayDenominator: 1_000_000,
+		},
+	))
+	require.NoError(t, err)
+
+	// A million decay steps are computed in logarithmic time, and match the
+	// exact decay up to the fixed point precision.
+	expected := 8e9 * math.Pow(0.999_999, 1_000_000)
+	require.InDelta(
+		t, expected, float64(cs.EVMInflationPerBlock(1_000_000*32)), 1,
+	)
+}
+
+func TestEVMInflationScheduleValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule []testInflationPeriod
+		err      error
+	}{
+		{
+			name:     "not starting at genesis",
+			schedule: []testInflationPeriod{{StartEpoch: 1}},
+			err:      chain.ErrInvalidEVMInflationSchedule,
+		},
+		{
+			name: "unsorted periods",
+			schedule: []testInflationPeriod{
+				{StartEpoch: 0}, {StartEpoch: 10}, {StartEpoch: 10},
+			},
+			err: chain.ErrInvalidEVMInflationSchedule,
+		},
+		{
+			name: "zero decay denominator",
+			schedule: []testInflationPeriod{
+				{StartEpoch: 0, DecayEpochs: 1, DecayNumerator: 1},
+			},
+			err: chain.ErrInvalidEVMInflationDecay,
+		},
+		{
+			name: "growing decay factor",
+			schedule: []testInflationPeriod{{
+				StartEpoch:       0,
+				DecayEpochs:      1,
+				DecayNumerator:   3,
+				DecayDenominator: 2,
+			}},
+			err: chain.ErrInvalidEVMInflationDecay,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chain.NewChainSpec(inflationSpecData(tt.schedule...))
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/pebbledb"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagBlobsDir overrides the directory of the file availability store.
+	FlagBlobsDir = "blobs-dir"
+	// FlagPebbleDir overrides the directory of the pebble availability store.
+	FlagPebbleDir = "pebble-dir"
+	// FlagRewriteLegacy rewrites the sidecars stored without checksum.
+	FlagRewriteLegacy = "rewrite-legacy"
+
+	// blobsDir is the directory of the file availability store, relative to
+	// the home directory.
+	blobsDir = "data/blobs"
+	// pebbleDir is the directory of the pebble availability store, relative
+	// to the home directory.
+	pebbleDir = "data"
+	// blobsExtension is the file extension of stored sidecars.
+	blobsExtension = "ssz"
+	// blobsDirPerm is the permission of the availability store directories.
+	blobsDirPerm = 0o700
+	// migrateBatchSize is the size in bytes above which migrated sidecars
+	// are written to the pebble availability store.
+	migrateBatchSize = 64 << 20
+)
+
+// Commands creates a new command for maintaining the node databases.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "Database maintenance subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.PersistentFlags().String(
+		FlagBlobsDir, "", "File availability store directory",
+	)
+
+	cmd.AddCommand(
+		NewMigrateBlobsCommand(),
+		NewVerifyBlobsCommand(),
+	)
+
+	return cmd
+}
+
+// NewVerifyBlobsCommand creates a new command for verifying the blob
+// sidecars of the availability store.
+func NewVerifyBlobsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-blobs",
+		Short: "Verifies the checksums of the stored blob sidecars",
+		Long: `Scans the availability store and verifies the checksum of every
+blob sidecar. Sidecars stored before checksums were introduced are verified by
+decoding them instead, and rewritten along with their checksum if --` +
+			FlagRewriteLegacy + ` is set. Corrupt sidecars are renamed with a .corrupt
+suffix so that the node reports them as unavailable and stores them again. The
+node must be stopped while verifying.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, err := dirFromFlag(cmd, FlagBlobsDir, blobsDir)
+			if err != nil {
+				return err
+			}
+			rewrite, err := cmd.Flags().GetBool(FlagRewriteLegacy)
+			if err != nil {
+				return err
+			}
+
+			report, err := newFileDB(dir).Verify(
+				validateLegacySidecar, rewrite,
+			)
+			if err != nil {
+				return err
+			}
+			for _, path := range report.Corrupt {
+				cmd.Printf("Corrupt blob sidecar: %s\n", path)
+			}
+			for _, path := range report.Vanished {
+				cmd.Printf("Blob sidecar removed while verifying: %s\n", path)
+			}
+			cmd.Printf(
+				"Verified %d blob sidecars, %d corrupt, %d without checksum, "+
+					"%d rewritten, %d removed while verifying\n",
+				report.Checked, len(report.Corrupt), report.Legacy,
+				report.Rewritten, len(report.Vanished),
+			)
+			return nil
+		},
+	}
+	cmd.Flags().Bool(
+		FlagRewriteLegacy, false,
+		"Rewrite valid sidecars stored without checksum along with one",
+	)
+	return cmd
+}
+
+// validateLegacySidecar checks that a value stored before checksums, and
+// therefore before compression, were introduced decodes as a blob sidecar.
+func validateLegacySidecar(value []byte) error {
+	return new(datypes.BlobSidecar).UnmarshalSSZ(value)
+}
+
+// NewMigrateBlobsCommand creates a new command for converting the file
+// availability store into a pebble one.
+func NewMigrateBlobsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-blobs",
+		Short: "Copies the file availability store into a pebble one",
+		Long: `Copies every blob sidecar of the file availability store into
+the pebble availability store. Set the availability store backend to "pebble"
+afterwards and remove the old directory once the node runs fine. The node must
+be stopped while migrating.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			src, err := dirFromFlag(cmd, FlagBlobsDir, blobsDir)
+			if err != nil {
+				return err
+			}
+			dst, err := dirFromFlag(cmd, FlagPebbleDir, pebbleDir)
+			if err != nil {
+				return err
+			}
+
+			db, err := pebbledb.Open(dst)
+			if err != nil {
+				return err
+			}
+			var migrated int
+			batch := db.NewBatch()
+			err = filedb.NewRangeDB(newFileDB(src)).Iterate(
+				func(index uint64, key, value []byte) error {
+					migrated++
+					if err = batch.Set(index, key, value); err != nil {
+						return err
+					}
+					if size, sErr := batch.Size(); sErr != nil ||
+						size < migrateBatchSize {
+						return sErr
+					}
+					if err = batch.Write(); err != nil {
+						return err
+					}
+					_ = batch.Close()
+					batch = db.NewBatch()
+					return nil
+				},
+			)
+			if err == nil {
+				err = batch.Write()
+			}
+			_ = batch.Close()
+			if cErr := db.Close(); err == nil {
+				err = cErr
+			}
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Migrated %d blob sidecars to %s\n", migrated, dst)
+			return nil
+		},
+	}
+	cmd.Flags().String(
+		FlagPebbleDir, "", "Pebble availability store directory",
+	)
+	return cmd
+}
+
+// dirFromFlag returns the directory given by the flag, or the default one
+// within the home directory.
+func dirFromFlag(cmd *cobra.Command, flag, defaultDir string) (string, error) {
+	dir, err := cmd.Flags().GetString(flag)
+	if err != nil || dir != "" {
+		return dir, err
+	}
+	return filepath.Join(cast.ToString(
+		clicontext.GetViperFromCmd(cmd).Get(flags.FlagHome),
+	), defaultDir), nil
+}
+
+// newFileDB opens the file availability store in dir.
+func newFileDB(dir string) *filedb.DB {
+	return filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension(blobsExtension),
+		filedb.WithDirectoryPermissions(blobsDirPerm),
+	)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBlobs(t *testing.T) {
+	dir := t.TempDir()
+	store := filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0o700),
+		filedb.WithLogger(noop.NewLogger[any]()),
+	))
+	require.NoError(t, store.Set(7, []byte{0x01}, []byte("sidecar")))
+	require.NoError(t, store.Set(7, []byte{0x02}, []byte("sidecar")))
+
+	path := filepath.Join(dir, "7", "0x02.ssz")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	// Sidecars stored before checksums were introduced are decoded instead.
+	legacy, err := datypes.BuildBlobSidecar(
+		0, &ctypes.BeaconBlockHeader{Slot: 8}, &eip4844.Blob{},
+		eip4844.KZGCommitment{}, eip4844.KZGProof{}, make([]common.Root, 8),
+	).MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "8"), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "8", "0x01.ssz"), legacy, 0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "8", "0x02.ssz"), legacy[1:], 0o600,
+	))
+
+	out := new(bytes.Buffer)
+	cmd := db.Commands()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{
+		"verify-blobs", "--blobs-dir", dir, "--rewrite-legacy",
+	})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(),
+		"Verified 4 blob sidecars, 2 corrupt, 2 without checksum, 1 rewritten",
+	)
+
+	exists, err := store.Has(7, []byte{0x02})
+	require.NoError(t, err)
+	require.False(t, exists)
+	values, err := store.GetByIndex(7)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("sidecar")}, values)
+
+	values, err = store.GetByIndex(8)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{legacy}, values)
+	bz, err = os.ReadFile(filepath.Join(dir, "8", "0x01.ssz"))
+	require.NoError(t, err)
+	require.NotEqual(t, legacy, bz)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build pebbledb
+
+package db_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/pebbledb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateBlobs(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	store := filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(src),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0o700),
+		filedb.WithLogger(noop.NewLogger[any]()),
+	))
+	require.NoError(t, store.Set(7, []byte{0x01}, []byte("a")))
+	require.NoError(t, store.Set(7, []byte{0x02}, []byte("b")))
+	require.NoError(t, store.Set(9, []byte{0x01}, []byte("c")))
+
+	out := new(bytes.Buffer)
+	cmd := db.Commands()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{
+		"migrate-blobs", "--blobs-dir", src, "--pebble-dir", dst,
+	})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "Migrated 3 blob sidecars")
+
+	migrated, err := pebbledb.Open(dst)
+	require.NoError(t, err)
+	defer migrated.Close()
+	values, err := migrated.GetByIndex(7)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, values)
+	values, err = migrated.GetByIndex(9)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("c")}, values)
+}
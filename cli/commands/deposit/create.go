@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/log"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
 	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-core/components"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
@@ -165,9 +166,20 @@ func getBLSSigner(
 		}
 	}
 
+	// Without a key override, sign like the node would, which may be through
+	// the configured remote signer.
+	var cfg *config.Config
+	if !overrideFlag {
+		cfg, err = config.ReadConfigFromAppOpts(clicontext.GetViperFromCmd(cmd))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return components.ProvideBlsSigner(
 		components.BlsSignerInput{
 			AppOpts: clicontext.GetViperFromCmd(cmd),
+			Config:  cfg,
 			PrivKey: legacyKey,
 		},
 	)
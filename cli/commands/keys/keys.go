@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultKeystoreDir is the directory keystores are imported into,
+	// relative to the home directory.
+	DefaultKeystoreDir = "keystores"
+
+	FlagKeystoreDir       = "keystore-dir"
+	FlagPasswordFile      = "password-file"
+	FlagFromPrivValidator = "from-priv-validator"
+	FlagKDF               = "kdf"
+
+	keystoreFilePerm = 0o600
+	keystoreDirPerm  = 0o700
+)
+
+// Commands creates a new command for managing validator keystores.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keys",
+		Short:                      "Validator keystore subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCommand(),
+		NewListCommand(),
+		NewExportPubkeyCommand(),
+	)
+
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing an EIP-2335
+// keystore.
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keystore-file]",
+		Short: "Imports an EIP-2335 keystore",
+		Long: `Imports an EIP-2335 keystore into the keystore directory, after
+checking that it decrypts with the password in --password-file. With
+--from-priv-validator, the plaintext CometBFT private validator key is
+encrypted into a new keystore instead. Point [beacon-kit.keystore] at the
+imported file to have the node use it.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, err := getKeystoreDir(cmd)
+			if err != nil {
+				return err
+			}
+			passwordFile, err := cmd.Flags().GetString(FlagPasswordFile)
+			if err != nil {
+				return err
+			}
+			password, err := signer.ReadPasswordFile(passwordFile)
+			if err != nil {
+				return err
+			}
+
+			keystore, err := loadOrCreateKeystore(cmd, args, password)
+			if err != nil {
+				return err
+			}
+
+			path, err := writeKeystore(dir, keystore)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Imported 0x%s to %s\n", keystore.Pubkey, path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagKeystoreDir, "", "Keystore directory")
+	cmd.Flags().String(FlagPasswordFile, "", "Keystore password file")
+	cmd.Flags().Bool(
+		FlagFromPrivValidator, false,
+		"Encrypt the CometBFT private validator key",
+	)
+	cmd.Flags().String(
+		FlagKDF, signer.KDFScrypt,
+		"Key derivation function of new keystores, scrypt or pbkdf2",
+	)
+	_ = cmd.MarkFlagRequired(FlagPasswordFile)
+	return cmd
+}
+
+// NewListCommand creates a new command for listing imported keystores.
+func NewListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the imported keystores",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, err := getKeystoreDir(cmd)
+			if err != nil {
+				return err
+			}
+			paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+			if err != nil {
+				return err
+			}
+			for _, path := range paths {
+				keystore, lErr := signer.LoadKeystore(path)
+				if lErr != nil {
+					return lErr
+				}
+				cmd.Printf("0x%s %s\n", keystore.Pubkey, path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagKeystoreDir, "", "Keystore directory")
+	return cmd
+}
+
+// NewExportPubkeyCommand creates a new command for printing the public key
+// of a keystore, or of the key the node signs with.
+func NewExportPubkeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-pubkey [keystore-file]",
+		Short: "Prints the BLS public key of a keystore or of the node",
+		Long: `Prints the BLS public key of the given keystore. Without a
+keystore, prints the public key of the key the node is configured to sign
+with, which may be a keystore, a remote signer or the CometBFT key.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				keystore, err := signer.LoadKeystore(args[0])
+				if err != nil {
+					return err
+				}
+				cmd.Printf("0x%s\n", keystore.Pubkey)
+				return nil
+			}
+
+			v := clicontext.GetViperFromCmd(cmd)
+			cfg, err := config.ReadConfigFromAppOpts(v)
+			if err != nil {
+				return err
+			}
+			blsSigner, err := components.ProvideBlsSigner(
+				components.BlsSignerInput{AppOpts: v, Config: cfg},
+			)
+			if err != nil {
+				return err
+			}
+			cmd.Println(blsSigner.PublicKey().String())
+			return nil
+		},
+	}
+	return cmd
+}
+
+// loadOrCreateKeystore loads the keystore given in args, checking that
+// password decrypts it, or encrypts the CometBFT key with password.
+func loadOrCreateKeystore(
+	cmd *cobra.Command,
+	args []string,
+	password string,
+) (*signer.Keystore, error) {
+	fromPrivVal, err := cmd.Flags().GetBool(FlagFromPrivValidator)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(args) == 1:
+		keystore, lErr := signer.LoadKeystore(args[0])
+		if lErr != nil {
+			return nil, lErr
+		}
+		if _, lErr = keystore.Decrypt(password); lErr != nil {
+			return nil, lErr
+		}
+		return keystore, nil
+	case fromPrivVal:
+		kdf, kErr := cmd.Flags().GetString(FlagKDF)
+		if kErr != nil {
+			return nil, kErr
+		}
+		secret, kErr := readPrivValidatorKey(
+			clicontext.GetConfigFromCmd(cmd).PrivValidatorKeyFile(),
+		)
+		if kErr != nil {
+			return nil, kErr
+		}
+		return signer.EncryptKeystore(secret, password, kdf)
+	default:
+		return nil, ErrNoKeystoreSource
+	}
+}
+
+// readPrivValidatorKey reads the secret key of a CometBFT private validator
+// key file.
+func readPrivValidatorKey(path string) (signer.LegacyKey, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+	var pvKey privval.FilePVKey
+	if err = cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return signer.LegacyKey{}, errors.Wrapf(err, "parsing %s", path)
+	}
+	if pvKey.PrivKey == nil {
+		return signer.LegacyKey{}, signer.ErrValidatorPrivateKeyRequired
+	}
+	secret := pvKey.PrivKey.Bytes()
+	if len(secret) != constants.BLSSecretKeyLength {
+		return signer.LegacyKey{}, signer.ErrInvalidValidatorPrivateKeyLength
+	}
+	return signer.LegacyKey(secret), nil
+}
+
+// writeKeystore writes keystore to dir, named after its pubkey.
+func writeKeystore(dir string, keystore *signer.Keystore) (string, error) {
+	if err := os.MkdirAll(dir, keystoreDirPerm); err != nil {
+		return "", err
+	}
+	bz, err := json.MarshalIndent(keystore, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, keystore.Pubkey+".json")
+	//#nosec:G304 // the path is derived from the keystore directory.
+	f, err := os.OpenFile(
+		path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keystoreFilePerm,
+	)
+	if errors.Is(err, os.ErrExist) {
+		return "", errors.Wrapf(ErrKeystoreExists, "%s", path)
+	} else if err != nil {
+		return "", err
+	}
+	if _, err = f.Write(bz); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// getKeystoreDir returns the keystore directory from the command flag, or
+// the default directory under the home directory.
+func getKeystoreDir(cmd *cobra.Command) (string, error) {
+	dir, err := cmd.Flags().GetString(FlagKeystoreDir)
+	if err != nil {
+		return "", err
+	}
+	if dir != "" {
+		return dir, nil
+	}
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return "", ErrNoClientCtx
+	}
+	return filepath.Join(clientCtx.HomeDir, DefaultKeystoreDir), nil
+}
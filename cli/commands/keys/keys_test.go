@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/keys"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// EIP-2335 PBKDF2 test vector.
+	testPassword = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	testPubkey   = "0x9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07"
+	testKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := keys.Commands()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestKeysCommands(t *testing.T) {
+	tmp := t.TempDir()
+	keystorePath := filepath.Join(tmp, "keystore.json")
+	passwordPath := filepath.Join(tmp, "password")
+	wrongPasswordPath := filepath.Join(tmp, "wrong")
+	dir := filepath.Join(tmp, "keystores")
+	require.NoError(t, os.WriteFile(keystorePath, []byte(testKeystore), 0o600))
+	require.NoError(t, os.WriteFile(passwordPath, []byte(testPassword), 0o600))
+	require.NoError(t, os.WriteFile(wrongPasswordPath, []byte("x"), 0o600))
+
+	t.Run("import rejects a wrong password", func(t *testing.T) {
+		_, err := run(t, "import", keystorePath,
+			"--keystore-dir", dir, "--password-file", wrongPasswordPath)
+		require.Error(t, err)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		out, err := run(t, "import", keystorePath,
+			"--keystore-dir", dir, "--password-file", passwordPath)
+		require.NoError(t, err)
+		require.Contains(t, out, testPubkey)
+
+		info, err := os.Stat(filepath.Join(dir, testPubkey[2:]+".json"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		_, err = run(t, "import", keystorePath,
+			"--keystore-dir", dir, "--password-file", passwordPath)
+		require.ErrorIs(t, err, keys.ErrKeystoreExists)
+	})
+
+	t.Run("import requires a source", func(t *testing.T) {
+		_, err := run(t, "import",
+			"--keystore-dir", dir, "--password-file", passwordPath)
+		require.ErrorIs(t, err, keys.ErrNoKeystoreSource)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		out, err := run(t, "list", "--keystore-dir", dir)
+		require.NoError(t, err)
+		require.Contains(t, out, testPubkey)
+	})
+
+	t.Run("export-pubkey", func(t *testing.T) {
+		out, err := run(t, "export-pubkey", keystorePath)
+		require.NoError(t, err)
+		require.Equal(t, testPubkey+"\n", out)
+	})
+}
@@ -21,11 +21,14 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/cli/commands/db"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
+	"github.com/berachain/beacon-kit/cli/commands/keys"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/slashingprotection"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -56,12 +59,18 @@ func DefaultRootCommandSetup[
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `db`
+		db.Commands(),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `keys`
+		keys.Commands(),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
+		// `slashing-protection`
+		slashingprotection.Commands(),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions[T]{
 			AddFlags: flags.AddBeaconKitFlags,
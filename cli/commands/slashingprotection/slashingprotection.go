@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagDBPath overrides the configured slashing protection database.
+	FlagDBPath = "db-path"
+
+	exportFilePerm = 0o600
+)
+
+// Commands creates a new command for managing the slashing protection
+// database.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "slashing-protection",
+		Short:                      "EIP-3076 slashing protection subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.PersistentFlags().String(
+		FlagDBPath, "", "Slashing protection database path",
+	)
+
+	cmd.AddCommand(
+		NewImportCommand(),
+		NewExportCommand(),
+	)
+
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing an EIP-3076
+// interchange file.
+func NewImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [interchange-file]",
+		Short: "Imports an EIP-3076 interchange file",
+		Long: `Merges an EIP-3076 interchange file into the slashing
+protection database, keeping the highest signed slot of every key, and the
+highest CometBFT height, round and step of the beacon-kit signed_consensus
+extension. The node must be stopped while importing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			//#nosec:G304 // the path is provided by the operator.
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var interchange protection.Interchange
+			if err = json.Unmarshal(bz, &interchange); err != nil {
+				return errors.Wrapf(err, "parsing %s", args[0])
+			}
+
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			if err = db.Import(&interchange); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Imported slashing protection data of %d keys\n",
+				len(interchange.Data),
+			)
+			return nil
+		},
+	}
+}
+
+// NewExportCommand creates a new command for exporting the slashing
+// protection database as an EIP-3076 interchange file.
+func NewExportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [interchange-file]",
+		Short: "Exports an EIP-3076 interchange file",
+		Long: `Exports the slashing protection database as an EIP-3076
+interchange file, to be imported on the host the validator key moves to. The
+CometBFT messages signed by the keys are exported in the signed_consensus
+extension of the format.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(db.Export(), "", "  ")
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(args[0], bz, exportFilePerm)
+		},
+	}
+}
+
+// openDB opens the database given by the flag, or the configured one.
+func openDB(cmd *cobra.Command) (*protection.DB, error) {
+	path, err := cmd.Flags().GetString(FlagDBPath)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		return protection.Open(path)
+	}
+
+	v := clicontext.GetViperFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		return nil, err
+	}
+	path = cfg.SlashingProtection.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cast.ToString(v.Get(flags.FlagHome)), path)
+	}
+	return protection.Open(path)
+}
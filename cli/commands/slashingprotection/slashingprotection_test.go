@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/slashingprotection"
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+const interchange = `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+  },
+  "data": [
+    {
+      "pubkey": "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+      "signed_blocks": [{"slot": "81952"}],
+      "signed_attestations": []
+    }
+  ]
+}`
+
+func run(t *testing.T, args ...string) error {
+	t.Helper()
+	cmd := slashingprotection.Commands()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs(args)
+	return cmd.Execute()
+}
+
+func TestImportExport(t *testing.T) {
+	tmp := t.TempDir()
+	in := filepath.Join(tmp, "in.json")
+	out := filepath.Join(tmp, "out.json")
+	dbPath := filepath.Join(tmp, "db.json")
+	require.NoError(t, os.WriteFile(in, []byte(interchange), 0o600))
+
+	require.NoError(t, run(t, "import", in, "--db-path", dbPath))
+	require.NoError(t, run(t, "export", out, "--db-path", dbPath))
+
+	bz, err := os.ReadFile(out)
+	require.NoError(t, err)
+	var exported protection.Interchange
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Len(t, exported.Data, 1)
+	require.Equal(t, uint64(81952), exported.Data[0].SignedBlocks[0].Slot)
+}
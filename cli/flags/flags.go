@@ -43,6 +43,7 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCBackupDialURLs       = engineRoot + "rpc-backup-dial-urls"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
@@ -51,9 +52,11 @@ const (
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
 
 	// KZG Config.
-	kzgRoot             = beaconKitRoot + "kzg."
-	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
-	KZGImplementation   = kzgRoot + "implementation"
+	kzgRoot               = beaconKitRoot + "kzg."
+	KZGTrustedSetupPath   = kzgRoot + "trusted-setup-path"
+	KZGImplementation     = kzgRoot + "implementation"
+	KZGSelfTest           = kzgRoot + "self-test"
+	KZGSelfTestVectorPath = kzgRoot + "self-test-vector-path"
 
 	// Logger Config.
 	loggerRoot = beaconKitRoot + "logger."
@@ -72,6 +75,55 @@ const (
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	// Tracing Config.
+	tracingRoot        = beaconKitRoot + "tracing."
+	TracingEnabled     = tracingRoot + "enabled"
+	TracingExporter    = tracingRoot + "exporter"
+	TracingEndpoint    = tracingRoot + "endpoint"
+	TracingInsecure    = tracingRoot + "insecure"
+	TracingFilePath    = tracingRoot + "file-path"
+	TracingSampleRatio = tracingRoot + "sample-ratio"
+	TracingServiceName = tracingRoot + "service-name"
+
+	// Metrics Config.
+	metricsRoot = beaconKitRoot + "metrics."
+	MetricsSink = metricsRoot + "sink"
+
+	// Remote Signer Config.
+	remoteSignerRoot       = beaconKitRoot + "remote-signer."
+	RemoteSignerURL        = remoteSignerRoot + "url"
+	RemoteSignerPubkey     = remoteSignerRoot + "pubkey"
+	RemoteSignerCACertPath = remoteSignerRoot + "ca-cert-path"
+	RemoteSignerClientCert = remoteSignerRoot + "client-cert-path"
+	RemoteSignerClientKey  = remoteSignerRoot + "client-key-path"
+
+	// Keystore Config.
+	keystoreRoot         = beaconKitRoot + "keystore."
+	KeystorePath         = keystoreRoot + "path"
+	KeystorePasswordFile = keystoreRoot + "password-file"
+
+	// Slashing Protection Config.
+	slashingProtectionRoot    = beaconKitRoot + "slashing-protection."
+	SlashingProtectionEnabled = slashingProtectionRoot + "enabled"
+	SlashingProtectionPath    = slashingProtectionRoot + "path"
+
+	// Availability Store Config.
+	availabilityStoreRoot        = beaconKitRoot + "availability-store."
+	AvailabilityStoreBackend     = availabilityStoreRoot + "backend"
+	AvailabilityStoreCompression = availabilityStoreRoot + "compression"
+
+	// Blob Archive Config.
+	blobArchiveRoot       = beaconKitRoot + "blob-archive."
+	BlobArchiveBackend    = blobArchiveRoot + "backend"
+	BlobArchiveDirectory  = blobArchiveRoot + "directory"
+	BlobArchiveS3Endpoint = blobArchiveRoot + "s3-endpoint"
+	BlobArchiveS3Bucket   = blobArchiveRoot + "s3-bucket"
+
+	// Sidecar Gossip Config.
+	sidecarGossipRoot         = beaconKitRoot + "sidecar-gossip."
+	SidecarGossipEnabled      = sidecarGossipRoot + "enabled"
+	SidecarGossipFetchTimeout = sidecarGossipRoot + "fetch-timeout"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
@@ -85,6 +137,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCBackupDialURLs, nil, "rpc backup dial urls",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -101,6 +156,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -116,6 +176,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.Implementation,
 		"kzg implementation",
 	)
+	startCmd.Flags().Bool(
+		KZGSelfTest,
+		defaultCfg.KZG.SelfTest,
+		"kzg self-test at startup",
+	)
+	startCmd.Flags().String(
+		KZGSelfTestVectorPath,
+		defaultCfg.KZG.SelfTestVectorPath,
+		"kzg self-test vector path, empty for the built-in vector",
+	)
 	startCmd.Flags().String(
 		TimeFormat,
 		defaultCfg.Logger.TimeFormat,
@@ -156,4 +226,129 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"tracing enabled",
+	)
+	startCmd.Flags().String(
+		TracingExporter,
+		defaultCfg.Tracing.Exporter,
+		"tracing exporter",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"tracing otlp endpoint",
+	)
+	startCmd.Flags().Bool(
+		TracingInsecure,
+		defaultCfg.Tracing.Insecure,
+		"tracing otlp insecure",
+	)
+	startCmd.Flags().String(
+		TracingFilePath,
+		defaultCfg.Tracing.FilePath,
+		"tracing file exporter path",
+	)
+	startCmd.Flags().Float64(
+		TracingSampleRatio,
+		defaultCfg.Tracing.SampleRatio,
+		"tracing sample ratio",
+	)
+	startCmd.Flags().String(
+		TracingServiceName,
+		defaultCfg.Tracing.ServiceName,
+		"tracing service name",
+	)
+	startCmd.Flags().String(
+		MetricsSink,
+		defaultCfg.Metrics.Sink,
+		"metrics sink",
+	)
+	startCmd.Flags().String(
+		RemoteSignerURL,
+		defaultCfg.RemoteSigner.URL,
+		"remote signer url",
+	)
+	startCmd.Flags().String(
+		RemoteSignerPubkey,
+		defaultCfg.RemoteSigner.Pubkey,
+		"remote signer pubkey",
+	)
+	startCmd.Flags().String(
+		RemoteSignerCACertPath,
+		defaultCfg.RemoteSigner.CACertPath,
+		"remote signer ca certificate path",
+	)
+	startCmd.Flags().String(
+		RemoteSignerClientCert,
+		defaultCfg.RemoteSigner.ClientCertPath,
+		"remote signer client certificate path",
+	)
+	startCmd.Flags().String(
+		RemoteSignerClientKey,
+		defaultCfg.RemoteSigner.ClientKeyPath,
+		"remote signer client key path",
+	)
+	startCmd.Flags().String(
+		KeystorePath,
+		defaultCfg.Keystore.Path,
+		"validator keystore path",
+	)
+	startCmd.Flags().String(
+		KeystorePasswordFile,
+		defaultCfg.Keystore.PasswordFile,
+		"validator keystore password file",
+	)
+	startCmd.Flags().Bool(
+		SlashingProtectionEnabled,
+		defaultCfg.SlashingProtection.Enabled,
+		"slashing protection enabled",
+	)
+	startCmd.Flags().String(
+		SlashingProtectionPath,
+		defaultCfg.SlashingProtection.Path,
+		"slashing protection database path",
+	)
+	startCmd.Flags().String(
+		AvailabilityStoreBackend,
+		defaultCfg.AvailabilityStore.Backend,
+		"availability store backend",
+	)
+	startCmd.Flags().String(
+		AvailabilityStoreCompression,
+		defaultCfg.AvailabilityStore.Compression,
+		"availability store compression algorithm",
+	)
+	startCmd.Flags().String(
+		BlobArchiveBackend,
+		defaultCfg.BlobArchive.Backend,
+		"blob archive backend",
+	)
+	startCmd.Flags().String(
+		BlobArchiveDirectory,
+		defaultCfg.BlobArchive.Directory,
+		"blob archive directory",
+	)
+	startCmd.Flags().String(
+		BlobArchiveS3Endpoint,
+		defaultCfg.BlobArchive.S3Endpoint,
+		"blob archive s3 endpoint",
+	)
+	startCmd.Flags().String(
+		BlobArchiveS3Bucket,
+		defaultCfg.BlobArchive.S3Bucket,
+		"blob archive s3 bucket",
+	)
+	startCmd.Flags().Bool(
+		SidecarGossipEnabled,
+		defaultCfg.SidecarGossip.Enabled,
+		"gossip blob sidecars outside of the proposal",
+	)
+	startCmd.Flags().Duration(
+		SidecarGossipFetchTimeout,
+		defaultCfg.SidecarGossip.FetchTimeout,
+		"timeout for fetching gossiped blob sidecars",
+	)
 }
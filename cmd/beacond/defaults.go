@@ -38,12 +38,16 @@ func DefaultComponents() []any {
 		components.ProvideBlockStore[
 			*BeaconBlock, *BeaconBlockBody, *Logger,
 		],
+		components.ProvideSlashingProtection,
+		components.ProvideKeystorePrivValidator,
 		components.ProvideBlsSigner,
+		components.ProvideHostedPrivValidators,
+		components.ProvideKeyring,
 		components.ProvideBlobProcessor[
 			*AvailabilityStore, *BeaconBlockBody,
 			*ConsensusSidecars, *BlobSidecar, *BlobSidecars, *Logger,
 		],
-		components.ProvideBlobProofVerifier,
+		components.ProvideBlobProofVerifier[*Logger],
 		components.ProvideChainService[
 			*AvailabilityStore,
 			*ConsensusBlock, *BeaconBlock, *BeaconBlockBody,
@@ -63,6 +67,7 @@ func DefaultComponents() []any {
 		// 	*BeaconStateMarshallable, *BlockStore, *KVStore, *StorageBackend,
 		// ],
 		components.ProvideDepositStore[*Deposit, *Logger],
+		components.ProvideValidatorRegistrations[*Logger],
 		components.ProvideEngineClient[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
@@ -88,6 +93,7 @@ func DefaultComponents() []any {
 			*Genesis, *KVStore, *Logger,
 			NodeAPIContext,
 		],
+		components.ProvideSidecarGossip[*AvailabilityStore, *Logger],
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody,
 		],
@@ -103,6 +109,7 @@ func DefaultComponents() []any {
 		],
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService[*Logger],
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
@@ -138,11 +145,17 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
 		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[
+			*ExecutionPayload, *PayloadAttributes, NodeAPIContext,
+		],
 		components.ProvideNodeAPIProofHandler[
 			*BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPISimulateHandler[NodeAPIContext, *Withdrawal],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconState, *CometBFTService, NodeAPIContext,
+		],
 	)
 
 	return c
@@ -24,13 +24,20 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/config/template"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
+	"github.com/berachain/beacon-kit/da/gossip"
 	"github.com/berachain/beacon-kit/da/kzg"
+	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/errors"
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -43,13 +50,22 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:            engineclient.DefaultConfig(),
-		Logger:            log.DefaultConfig(),
-		KZG:               kzg.DefaultConfig(),
-		PayloadBuilder:    builder.DefaultConfig(),
-		Validator:         validator.DefaultConfig(),
-		BlockStoreService: blockstore.DefaultConfig(),
-		NodeAPI:           server.DefaultConfig(),
+		Engine:             engineclient.DefaultConfig(),
+		Logger:             log.DefaultConfig(),
+		KZG:                kzg.DefaultConfig(),
+		PayloadBuilder:     builder.DefaultConfig(),
+		Validator:          validator.DefaultConfig(),
+		BlockStoreService:  blockstore.DefaultConfig(),
+		NodeAPI:            server.DefaultConfig(),
+		Tracing:            tracing.DefaultConfig(),
+		Metrics:            metrics.DefaultConfig(),
+		RemoteSigner:       signer.DefaultRemoteConfig(),
+		Keystore:           signer.KeystoreConfig{},
+		HostedValidators:   signer.DefaultHostedConfig(),
+		SlashingProtection: protection.DefaultConfig(),
+		AvailabilityStore:  dastore.DefaultConfig(),
+		BlobArchive:        archive.DefaultConfig(),
+		SidecarGossip:      gossip.DefaultConfig(),
 	}
 }
 
@@ -69,6 +85,27 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for request-scoped tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// Metrics is the configuration for the metrics sink.
+	Metrics metrics.Config `mapstructure:"metrics"`
+	// RemoteSigner is the configuration for the remote BLS signer.
+	RemoteSigner signer.RemoteConfig `mapstructure:"remote-signer"`
+	// Keystore is the configuration for the encrypted validator key.
+	Keystore signer.KeystoreConfig `mapstructure:"keystore"`
+	// HostedValidators is the configuration for the additional validators
+	// hosted by the node.
+	HostedValidators signer.HostedConfig `mapstructure:"hosted-validators"`
+	// SlashingProtection is the configuration for the slashing protection
+	// database.
+	SlashingProtection protection.Config `mapstructure:"slashing-protection"`
+	// AvailabilityStore is the configuration for the blob sidecar store.
+	AvailabilityStore dastore.Config `mapstructure:"availability-store"`
+	// BlobArchive is the configuration for the blob sidecar archive.
+	BlobArchive archive.Config `mapstructure:"blob-archive"`
+	// SidecarGossip is the configuration for gossiping blob sidecars
+	// outside of the proposal.
+	SidecarGossip gossip.Config `mapstructure:"sidecar-gossip"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -58,6 +58,7 @@ func BaseSpec() chain.SpecData[
 		MinDepositAmount:               1e9,
 		MaxEffectiveBalancePreUpgrade:  32e9,
 		MaxEffectiveBalancePostUpgrade: 32e9,
+		MaxEffectiveBalanceCompounding: 2048e9,
 		EjectionBalance:                16e9,
 		EffectiveBalanceIncrement:      1e9,
 
@@ -114,6 +115,11 @@ func BaseSpec() chain.SpecData[
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
 
+		// Validator cycle constants.
+		MinPerEpochChurnLimit:           4,
+		ChurnLimitQuotient:              65536,
+		MaxPerEpochActivationChurnLimit: 8,
+
 		// Max operations per block constants.
 		MaxDepositsPerBlock: 16,
 
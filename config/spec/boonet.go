@@ -61,5 +61,11 @@ func BoonetChainSpec() (chain.Spec[
 	//nolint:mnd // ok.
 	boonetSpec.MaxEffectiveBalancePostUpgrade = 5_000_000 * 1e9
 
+	// MaxEffectiveBalanceCompounding cannot be lower than the post upgrade
+	// max effective balance.
+	//
+	//nolint:mnd // ok.
+	boonetSpec.MaxEffectiveBalanceCompounding = 5_000_000 * 1e9
+
 	return chain.NewChainSpec(boonetSpec)
 }
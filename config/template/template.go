@@ -26,21 +26,55 @@ const TomlTemplate = `
 ###############################################################################
 
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# URL of the execution client JSON-RPC endpoint. Use http(s)://host:port for
+# the authenticated engine API, or ipc:///path/to/engine.ipc for the IPC socket
+# of an execution client running on the same host.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
-# Number of retries before shutting down consensus client.
+# URLs of hot-standby execution clients, HTTP(S) or IPC, in order of
+# preference. They receive the same newPayload and forkchoiceUpdated calls as
+# the primary and take over while it is unreachable. They must share the JWT
+# secret.
+rpc-backup-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCBackupDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Number of times idempotent requests are retried after a network error.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
-# RPC timeout for execution client requests.
+# Backoff before the first retry, doubled on every retry and jittered.
+rpc-retry-backoff = "{{ .BeaconKit.Engine.RPCRetryBackoff }}"
+
+# Maximum backoff between retries.
+rpc-retry-max-backoff = "{{ .BeaconKit.Engine.RPCRetryMaxBackoff }}"
+
+# RPC timeout for execution client requests without a dedicated timeout.
 rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 
+# Deadline of engine_newPayload requests, retries included.
+rpc-new-payload-timeout = "{{ .BeaconKit.Engine.RPCNewPayloadTimeout }}"
+
+# Deadline of engine_forkchoiceUpdated requests.
+rpc-forkchoice-updated-timeout = "{{ .BeaconKit.Engine.RPCForkchoiceTimeout }}"
+
+# Deadline of engine_getPayload requests, retries included.
+rpc-get-payload-timeout = "{{ .BeaconKit.Engine.RPCGetPayloadTimeout }}"
+
+# Number of consecutive network errors after which requests to an execution
+# client fail fast.
+rpc-circuit-breaker-threshold = "{{ .BeaconKit.Engine.RPCBreakerThreshold }}"
+
+# How long requests fail fast before the execution client is probed again.
+rpc-circuit-breaker-cooldown = "{{ .BeaconKit.Engine.RPCBreakerCooldown }}"
+
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval at which every execution client is health checked when backups are
+# configured.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
@@ -63,12 +97,22 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+# Whether to verify a known blob, commitment and proof at startup. The node
+# falls back to "crate-crypto/go-kzg-4844" if the implementation above is
+# unavailable or fails verification.
+self-test = {{.BeaconKit.KZG.SelfTest}}
+
+# Path to the blob, commitment and proof verified at startup. Leave empty to
+# use the built-in ones.
+self-test-vector-path = "{{.BeaconKit.KZG.SelfTestVectorPath}}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 
 # Post bellatrix, this address will receive the transaction fees produced by any blocks
-# from this node.
+# from this node, unless a validator registered its own fee recipient through the
+# /eth/v1/validator/prepare_beacon_proposer endpoint.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
 # The timeout for local build payload. This should match, or be slightly less
@@ -84,6 +128,13 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# TargetGasLimit is the gas limit the payloads built for the validators of this node should
+# move towards, unless they registered their own through the
+# /eth/v1/validator/register_validator endpoint. It only applies to the payloads built by the
+# local execution client, which must be configured with the same target: payloads not moving
+# towards it are still proposed, with a warning. Zero leaves the gas limit to the execution client.
+target-gas-limit = {{.BeaconKit.Validator.TargetGasLimit}}
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -100,4 +151,132 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.tracing]
+# Enabled determines if spans are recorded and exported.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Exporter is the span exporter to use.
+# Options are "otlp" (OTLP over HTTP) or "file" (JSON lines, for local runs).
+exporter = "{{ .BeaconKit.Tracing.Exporter }}"
+
+# Endpoint is the host:port of the OTLP/HTTP collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS when talking to the OTLP collector.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# FilePath is the path spans are written to by the file exporter.
+file-path = "{{ .BeaconKit.Tracing.FilePath }}"
+
+# SampleRatio is the fraction of root spans that are sampled, between 0 and 1.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+# ServiceName is the service name attached to every exported span.
+service-name = "{{ .BeaconKit.Tracing.ServiceName }}"
+
+[beacon-kit.metrics]
+# Sink selects where metrics are recorded.
+# Options are "telemetry" (cosmos-sdk telemetry, requires [telemetry] enabled)
+# or "prometheus" (typed Prometheus registry, served on the CometBFT
+# instrumentation listener).
+sink = "{{ .BeaconKit.Metrics.Sink }}"
+
+[beacon-kit.remote-signer]
+# URL of a Web3Signer-compatible remote signer. When set, RANDAO reveals and
+# deposits are signed remotely and no BLS key needs to be present locally.
+url = "{{ .BeaconKit.RemoteSigner.URL }}"
+
+# Hex-encoded BLS public key the remote signer signs for.
+pubkey = "{{ .BeaconKit.RemoteSigner.Pubkey }}"
+
+# CA certificate used to verify the remote signer. Defaults to the system pool.
+ca-cert-path = "{{ .BeaconKit.RemoteSigner.CACertPath }}"
+
+# Client certificate and key presented to the remote signer (mTLS).
+client-cert-path = "{{ .BeaconKit.RemoteSigner.ClientCertPath }}"
+client-key-path = "{{ .BeaconKit.RemoteSigner.ClientKeyPath }}"
+
+# Deadline of a single signing request.
+timeout = "{{ .BeaconKit.RemoteSigner.Timeout }}"
+
+[beacon-kit.keystore]
+# Path of an EIP-2335 keystore holding the BLS key, used instead of the
+# plaintext CometBFT private validator key when set. CometBFT then signs with
+# the decrypted key held in memory, so priv_validator_key.json can be removed;
+# if it is kept, it must hold the key of the keystore or the node does not
+# start. Relative paths are resolved against the home directory.
+path = "{{ .BeaconKit.Keystore.Path }}"
+
+# Path of the file holding the keystore password.
+password-file = "{{ .BeaconKit.Keystore.PasswordFile }}"
+
+[beacon-kit.hosted-validators]
+# CometBFT private validator key files of the validators hosted by this node
+# in addition to its own private validator. Each height, CometBFT acts as
+# the hosted validator proposing its first round, or else as one in the
+# validator set, and its votes are also cast by the other hosted validators
+# in the validator set. Rounds after the first proposed by a hosted validator
+# time out. Cannot be combined with priv_validator_laddr. Relative paths are
+# resolved against the home directory.
+priv-validator-key-files = [{{ range $i, $file := .BeaconKit.HostedValidators.PrivValidatorKeyFiles }}{{ if $i }}, {{ end }}"{{ $file }}"{{ end }}]
+
+# Directory holding the last sign state of each hosted validator, preventing
+# it from double signing.
+state-dir = "{{ .BeaconKit.HostedValidators.StateDir }}"
+
+[beacon-kit.slashing-protection]
+# Enabled determines if the node refuses to sign a CometBFT proposal or vote
+# at a height, round and step lower than, or conflicting with, what it signed
+# before, and likewise for the RANDAO reveals of its blocks. Move the database
+# along with the key when migrating hosts, with
+# "beacond slashing-protection export/import".
+enabled = "{{ .BeaconKit.SlashingProtection.Enabled }}"
+
+# Path of the EIP-3076 slashing protection database. Relative paths are
+# resolved against the home directory.
+path = "{{ .BeaconKit.SlashingProtection.Path }}"
+
+[beacon-kit.availability-store]
+# Database blob sidecars are stored in. Options are "filedb" (one file per
+# sidecar) or "pebble". Convert an existing store with
+# "beacond db migrate-blobs" before switching.
+backend = "{{ .BeaconKit.AvailabilityStore.Backend }}"
+
+# Algorithm blob sidecars are compressed with at rest. Options are ""
+# (disabled), "zstd" or "snappy". Compressed and uncompressed sidecars can
+# coexist, so this can be changed at any time.
+compression = "{{ .BeaconKit.AvailabilityStore.Compression }}"
+
+[beacon-kit.blob-archive]
+# Backend sidecars are archived to before being pruned, so that the blob API
+# keeps serving them. Options are "" (disabled), "local" or "s3".
+backend = "{{ .BeaconKit.BlobArchive.Backend }}"
+
+# Directory of the local archive. Relative paths are resolved against the
+# home directory.
+directory = "{{ .BeaconKit.BlobArchive.Directory }}"
+
+# URL of the S3-compatible service, e.g. "https://s3.us-east-1.amazonaws.com"
+# or "http://localhost:9000" for MinIO.
+s3-endpoint = "{{ .BeaconKit.BlobArchive.S3Endpoint }}"
+
+# Bucket, key prefix and signing region of the S3 archive.
+s3-bucket = "{{ .BeaconKit.BlobArchive.S3Bucket }}"
+s3-prefix = "{{ .BeaconKit.BlobArchive.S3Prefix }}"
+s3-region = "{{ .BeaconKit.BlobArchive.S3Region }}"
+
+# Credentials of the S3 archive. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+# are used when empty.
+s3-access-key = "{{ .BeaconKit.BlobArchive.S3AccessKey }}"
+s3-secret-key = "{{ .BeaconKit.BlobArchive.S3SecretKey }}"
+
+[beacon-kit.sidecar-gossip]
+# Enabled makes this node gossip the blob sidecars of its proposals on a
+# dedicated p2p channel instead of including them in the proposal. Every node
+# accepts gossiped sidecars regardless of this setting.
+enabled = {{ .BeaconKit.SidecarGossip.Enabled }}
+
+# Time to wait for the sidecars of a proposal that does not include them.
+fetch-timeout = "{{ .BeaconKit.SidecarGossip.FetchTimeout }}"
 `
@@ -59,7 +59,8 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb:
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
@@ -67,6 +68,17 @@ func (b *BeaconBlock) NewWithVersion(
 			StateRoot:     common.Root{},
 			Body:          &BeaconBlockBody{},
 		}, nil
+	case version.Electra:
+		return &BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentBlockRoot,
+			StateRoot:     common.Root{},
+			Body: &BeaconBlockBody{
+				forkVersion:       version.Electra,
+				ExecutionRequests: new(ExecutionRequests),
+			},
+		}, nil
 	}
 
 	return nil, errors.Wrap(
@@ -80,9 +92,17 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb:
 		block := &BeaconBlock{}
 		return block, block.UnmarshalSSZ(bz)
+	case version.Electra:
+		// The body is allocated ahead of decoding so that it is decoded
+		// with the Electra fields.
+		block := &BeaconBlock{
+			Body: &BeaconBlockBody{forkVersion: version.Electra},
+		}
+		return block, block.UnmarshalSSZ(bz)
 	}
 
 	return nil, errors.Wrap(
@@ -207,7 +227,10 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
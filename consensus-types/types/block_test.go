@@ -198,3 +198,52 @@ func TestBeaconBlock_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlockElectraFromSSZ(t *testing.T) {
+	block, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1, 2, 3, 4, 5}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, block.Version())
+
+	deneb := generateValidBeaconBlock()
+	body := block.GetBody()
+	body.SetExecutionPayload(deneb.Body.ExecutionPayload)
+	body.SetEth1Data(deneb.Body.Eth1Data)
+	body.SetDeposits(deneb.Body.Deposits)
+	body.SetBlobKzgCommitments(deneb.Body.BlobKzgCommitments)
+	body.SetExecutionRequests(&types.ExecutionRequests{
+		Deposits: []*types.Deposit{},
+		Withdrawals: []*types.WithdrawalRequest{
+			{SourceAddress: common.ExecutionAddress{1}, Amount: 32},
+		},
+		Consolidations: []*types.ConsolidationRequest{
+			{SourceAddress: common.ExecutionAddress{2}},
+		},
+	})
+
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, decoded.Version())
+	require.Equal(t, block.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t,
+		block.GetBody().GetExecutionRequests(),
+		decoded.GetBody().GetExecutionRequests(),
+	)
+
+	// The fastssz tree agrees with the karalabe root.
+	tree, err := decoded.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, decoded.HashTreeRoot(), common.Root(tree.Hash()))
+
+	// The execution requests are part of the body root from Electra.
+	deneb.Slot, deneb.ProposerIndex = block.Slot, block.ProposerIndex
+	deneb.ParentRoot, deneb.StateRoot = block.ParentRoot, block.StateRoot
+	require.NotEqual(t, deneb.HashTreeRoot(), block.HashTreeRoot())
+
+	// Electra blocks do not decode as Deneb blocks.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(bz, version.Deneb)
+	require.Error(t, err)
+}
@@ -40,11 +40,16 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the Electra
+	// BeaconBlockBody, which appends the execution requests.
+	BodyLengthElectra uint64 = 7
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
-	// in the merkle tree built from the block body.
+	// in the merkle tree built from the block body. Electra keeps the body
+	// tree at 8 leaves, so the index is unchanged.
 	KZGMerkleIndexDeneb = 26
 
 	// ExtraDataSize is the size of ExtraData in bytes.
@@ -62,6 +67,15 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, ExtraDataSize),
 			},
 		}
+	case version.Electra:
+		return &BeaconBlockBody{
+			forkVersion: version.Electra,
+			Eth1Data:    new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			ExecutionRequests: new(ExecutionRequests),
+		}
 	default:
 		panic(ErrForkVersionNotSupported)
 	}
@@ -75,7 +89,7 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) (uint64, error) {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock(), nil
 	default:
 		return 0, ErrForkVersionNotSupported
@@ -83,8 +97,11 @@ func BlockBodyKZGOffset(
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. From Electra it also carries the execution requests.
 type BeaconBlockBody struct {
+	// forkVersion is the fork version the body is encoded for. Bodies not
+	// created for a fork version are Deneb bodies.
+	forkVersion uint32
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
 	// Eth1Data is the data from the Eth1 chain.
@@ -97,6 +114,9 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// ExecutionRequests are the EIP-7685 requests of the execution payload,
+	// only present from Electra.
+	ExecutionRequests *ExecutionRequests
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +126,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.isElectra() {
+		size += 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +136,9 @@ func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
 	size += ssz.SizeDynamicObject(siz, b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if b.isElectra() {
+		size += ssz.SizeDynamicObject(siz, b.ExecutionRequests)
+	}
 	return size
 }
 
@@ -127,11 +153,17 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -221,6 +253,16 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	// Field (6) 'ExecutionRequests'
+	if b.isElectra() {
+		if b.ExecutionRequests == nil {
+			b.ExecutionRequests = new(ExecutionRequests)
+		}
+		if err := b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -291,7 +333,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,13 +342,53 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.isElectra() {
+		roots = append(roots, b.ExecutionRequests.HashTreeRoot())
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.isElectra() {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
+// Version returns the fork version of the BeaconBlockBody.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.isElectra() {
+		return version.Electra
+	}
+	return version.Deneb
+}
+
+// isElectra returns whether the body has the Electra fields.
+func (b *BeaconBlockBody) isElectra() bool {
+	return b.forkVersion >= version.Electra
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the Body, nil
+// before Electra.
+func (b *BeaconBlockBody) GetExecutionRequests() *ExecutionRequests {
+	return b.ExecutionRequests
+}
+
+// GetDepositRequests returns the EIP-6110 deposit requests of the Body, nil
+// before Electra.
+func (b *BeaconBlockBody) GetDepositRequests() []*Deposit {
+	if b.ExecutionRequests == nil {
+		return nil
+	}
+	return b.ExecutionRequests.Deposits
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the Body.
+func (b *BeaconBlockBody) SetExecutionRequests(requests *ExecutionRequests) {
+	b.ExecutionRequests = requests
+}
+
 // GetRandaoReveal returns the RandaoReveal of the Body.
 func (b *BeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
 	return b.RandaoReveal
@@ -226,3 +226,9 @@ func (d *Deposit) GetWithdrawalCredentials() WithdrawalCredentials {
 func (d *Deposit) HasEth1WithdrawalCredentials() bool {
 	return d.Credentials[0] == EthSecp256k1CredentialPrefix
 }
+
+// HasCompoundingWithdrawalCredentials returns true if the deposit has EIP-7251
+// compounding withdrawal credentials.
+func (d *Deposit) HasCompoundingWithdrawalCredentials() bool {
+	return d.Credentials.IsCompounding()
+}
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -56,6 +57,24 @@ func CreateAndSignDepositMessage(
 		Amount:      amount,
 	}
 	signingRoot := ComputeSigningRoot(depositMessage, domain)
+
+	// Remote signers need to know what they sign.
+	if depositSigner, ok := signer.(crypto.DepositSigner); ok {
+		signature, err := depositSigner.SignDeposit(
+			crypto.DepositInfo{
+				Pubkey:                depositMessage.Pubkey,
+				WithdrawalCredentials: bytes.B32(credentials),
+				Amount:                amount.Unwrap(),
+				GenesisForkVersion:    forkData.CurrentVersion,
+			},
+			bytes.B32(signingRoot),
+		)
+		if err != nil {
+			return nil, crypto.BLSSignature{}, err
+		}
+		return depositMessage, signature, nil
+	}
+
 	signature, err := signer.Sign(signingRoot[:])
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
@@ -27,6 +27,10 @@ var (
 	// match.
 	ErrDepositMessage = errors.New("invalid deposit message")
 
+	// ErrValidatorRegistration is an error for when the validator
+	// registration signature doesn't match.
+	ErrValidatorRegistration = errors.New("invalid validator registration")
+
 	// ErrInvalidWithdrawalCredentials is an error for when the.
 	ErrInvalidWithdrawalCredentials = errors.New(
 		"invalid withdrawal credentials",
@@ -38,4 +42,8 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrInvalidExecutionRequests is an error for when the EIP-7685
+	// execution requests of a payload are malformed.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
 )
@@ -0,0 +1,396 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// WithdrawalRequestSize is the size of the SSZ encoding of a
+	// WithdrawalRequest.
+	WithdrawalRequestSize = 76 // 20 + 48 + 8
+
+	// ConsolidationRequestSize is the size of the SSZ encoding of a
+	// ConsolidationRequest.
+	ConsolidationRequestSize = 116 // 20 + 48 + 48
+)
+
+// Request types of the EIP-7685 execution requests list.
+const (
+	// DepositRequestType is the type of EIP-6110 deposit requests.
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the type of EIP-7002 withdrawal requests.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the type of EIP-7251 consolidation requests.
+	ConsolidationRequestType byte = 0x02
+)
+
+// Compile-time assertions to ensure the requests implement necessary
+// interfaces.
+var (
+	_ ssz.StaticObject  = (*WithdrawalRequest)(nil)
+	_ ssz.StaticObject  = (*ConsolidationRequest)(nil)
+	_ ssz.DynamicObject = (*ExecutionRequests)(nil)
+)
+
+// ExecutionRequests are the requests made by the execution layer to the
+// consensus layer in a payload, as introduced by EIP-7685 in Electra.
+type ExecutionRequests struct {
+	// Deposits are the EIP-6110 deposit requests, which share the layout of
+	// a Deposit.
+	Deposits []*Deposit `json:"deposits"`
+	// Withdrawals are the EIP-7002 withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations are the EIP-7251 consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// WithdrawalRequest is an EIP-7002 request to withdraw from a validator,
+// triggered by its withdrawal address.
+type WithdrawalRequest struct {
+	// SourceAddress is the withdrawal address of the validator.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the validator.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw, zero for a full exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// ConsolidationRequest is an EIP-7251 request to move the balance of a
+// validator to another, triggered by the source withdrawal address.
+type ConsolidationRequest struct {
+	// SourceAddress is the withdrawal address of the source validator.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// SourcePubkey is the public key of the source validator.
+	SourcePubkey crypto.BLSPubkey `json:"source_pubkey"`
+	// TargetPubkey is the public key of the target validator.
+	TargetPubkey crypto.BLSPubkey `json:"target_pubkey"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  EIP-7685                                  */
+/* -------------------------------------------------------------------------- */
+
+// DecodeExecutionRequests decodes the EIP-7685 requests list returned by
+// engine_getPayloadV4, where each element is the request type followed by
+// the SSZ encoding of the requests of that type. Types must be in strictly
+// ascending order and empty elements are not allowed.
+func DecodeExecutionRequests(list []bytes.Bytes) (*ExecutionRequests, error) {
+	var (
+		reqs     = &ExecutionRequests{}
+		prevType = -1
+	)
+	for i, elem := range list {
+		if len(elem) < 2 {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequests, "empty request at %d", i,
+			)
+		}
+		reqType, data := elem[0], elem[1:]
+		if int(reqType) <= prevType {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"request type %d out of order", reqType,
+			)
+		}
+		prevType = int(reqType)
+
+		var err error
+		switch reqType {
+		case DepositRequestType:
+			reqs.Deposits, err = decodeRequests[*Deposit](
+				data, DepositSize, constants.MaxDepositRequestsPerPayload,
+			)
+		case WithdrawalRequestType:
+			reqs.Withdrawals, err = decodeRequests[*WithdrawalRequest](
+				data, WithdrawalRequestSize,
+				constants.MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			reqs.Consolidations, err = decodeRequests[*ConsolidationRequest](
+				data, ConsolidationRequestSize,
+				constants.MaxConsolidationRequestsPerPayload,
+			)
+		default:
+			err = errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"unknown request type %d", reqType,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reqs, nil
+}
+
+// EncodeExecutionRequests encodes the requests into the EIP-7685 requests
+// list expected by engine_newPayloadV4, omitting types without requests.
+func EncodeExecutionRequests(reqs *ExecutionRequests) ([]bytes.Bytes, error) {
+	list := make([]bytes.Bytes, 0)
+	if reqs == nil {
+		return list, nil
+	}
+	list, err := appendRequests(list, DepositRequestType, reqs.Deposits)
+	if err != nil {
+		return nil, err
+	}
+	list, err = appendRequests(list, WithdrawalRequestType, reqs.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+	return appendRequests(list, ConsolidationRequestType, reqs.Consolidations)
+}
+
+// decodeRequests decodes the concatenated SSZ encodings of static requests.
+func decodeRequests[T interface {
+	*U
+	UnmarshalSSZ([]byte) error
+}, U any](data []byte, size int, limit uint64) ([]T, error) {
+	if len(data)%size != 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequests,
+			"length %d is not a multiple of %d", len(data), size,
+		)
+	}
+	if count := uint64(len(data) / size); count > limit {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequests,
+			"%d requests exceed the limit of %d", count, limit,
+		)
+	}
+	reqs := make([]T, len(data)/size)
+	for i := range reqs {
+		reqs[i] = T(new(U))
+		if err := reqs[i].UnmarshalSSZ(data[i*size : (i+1)*size]); err != nil {
+			return nil, err
+		}
+	}
+	return reqs, nil
+}
+
+// appendRequests appends the requests of a type to the requests list, as
+// the type followed by the concatenated SSZ encodings of the requests.
+func appendRequests[T interface{ MarshalSSZ() ([]byte, error) }](
+	list []bytes.Bytes,
+	reqType byte,
+	reqs []T,
+) ([]bytes.Bytes, error) {
+	if len(reqs) == 0 {
+		return list, nil
+	}
+	elem := []byte{reqType}
+	for _, req := range reqs {
+		bz, err := req.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		elem = append(elem, bz...)
+	}
+	return append(list, elem), nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ExecutionRequests in SSZ.
+func (r *ExecutionRequests) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(siz, r.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(siz, r.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(siz, r.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the ExecutionRequests.
+func (r *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Consolidations, constants.MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Consolidations, constants.MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// MarshalSSZ serializes the ExecutionRequests to SSZ-encoded bytes.
+func (r *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(r))
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ deserializes the ExecutionRequests from SSZ-encoded bytes.
+func (r *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the ExecutionRequests.
+func (r *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher.
+func (r *ExecutionRequests) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Deposits'
+	if err := hashRequestsWith(
+		hh, r.Deposits, constants.MaxDepositRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+
+	// Field (1) 'Withdrawals'
+	if err := hashRequestsWith(
+		hh, r.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+
+	// Field (2) 'Consolidations'
+	if err := hashRequestsWith(
+		hh, r.Consolidations, constants.MaxConsolidationRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// hashRequestsWith ssz hashes a list of requests with a hasher.
+func hashRequestsWith[T interface {
+	HashTreeRootWith(fastssz.HashWalker) error
+}](hh fastssz.HashWalker, reqs []T, limit uint64) error {
+	if uint64(len(reqs)) > limit {
+		return fastssz.ErrIncorrectListSize
+	}
+	subIndx := hh.Index()
+	for _, req := range reqs {
+		if err := req.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, uint64(len(reqs)), limit)
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the WithdrawalRequest.
+func (*WithdrawalRequest) SizeSSZ(*ssz.Sizer) uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest.
+func (w *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &w.SourceAddress)
+	ssz.DefineStaticBytes(c, &w.ValidatorPubkey)
+	ssz.DefineUint64(c, &w.Amount)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(w))
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the WithdrawalRequest from SSZ format.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+// HashTreeRoot computes the Merkleization of the WithdrawalRequest.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(w.SourceAddress[:])
+	hh.PutBytes(w.ValidatorPubkey[:])
+	hh.PutUint64(uint64(w.Amount))
+	hh.Merkleize(indx)
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the ConsolidationRequest.
+func (*ConsolidationRequest) SizeSSZ(*ssz.Sizer) uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest.
+func (c *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &c.SourceAddress)
+	ssz.DefineStaticBytes(codec, &c.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &c.TargetPubkey)
+}
+
+// MarshalSSZ marshals the ConsolidationRequest to SSZ format.
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the ConsolidationRequest from SSZ format.
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// HashTreeRoot computes the Merkleization of the ConsolidationRequest.
+func (c *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest with a hasher.
+func (c *ConsolidationRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(c.SourceAddress[:])
+	hh.PutBytes(c.SourcePubkey[:])
+	hh.PutBytes(c.TargetPubkey[:])
+	hh.Merkleize(indx)
+	return nil
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequestsEncodeDecode(t *testing.T) {
+	reqs := &types.ExecutionRequests{
+		Deposits: []*types.Deposit{
+			{Pubkey: crypto.BLSPubkey{1}, Amount: 32e9, Index: 3},
+		},
+		Consolidations: []*types.ConsolidationRequest{
+			{
+				SourceAddress: common.ExecutionAddress{2},
+				SourcePubkey:  crypto.BLSPubkey{3},
+				TargetPubkey:  crypto.BLSPubkey{4},
+			},
+		},
+	}
+
+	list, err := types.EncodeExecutionRequests(reqs)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, types.DepositRequestType, list[0][0])
+	require.Len(t, list[0], 1+types.DepositSize)
+	require.Equal(t, types.ConsolidationRequestType, list[1][0])
+	require.Len(t, list[1], 1+types.ConsolidationRequestSize)
+
+	decoded, err := types.DecodeExecutionRequests(list)
+	require.NoError(t, err)
+	require.Equal(t, reqs, decoded)
+
+	// No requests encode to an empty list.
+	list, err = types.EncodeExecutionRequests(nil)
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
+
+func TestDecodeExecutionRequestsInvalid(t *testing.T) {
+	withdrawal := make(bytes.Bytes, 1+types.WithdrawalRequestSize)
+	withdrawal[0] = types.WithdrawalRequestType
+
+	testCases := []struct {
+		name string
+		list []bytes.Bytes
+	}{
+		{
+			name: "empty element",
+			list: []bytes.Bytes{{types.WithdrawalRequestType}},
+		},
+		{
+			name: "duplicate type",
+			list: []bytes.Bytes{withdrawal, withdrawal},
+		},
+		{
+			name: "unknown type",
+			list: []bytes.Bytes{{0x7f, 0x00}},
+		},
+		{
+			name: "truncated request",
+			list: []bytes.Bytes{withdrawal[:len(withdrawal)-1]},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.DecodeExecutionRequests(tc.list)
+			require.ErrorIs(t, err, types.ErrInvalidExecutionRequests)
+		})
+	}
+}
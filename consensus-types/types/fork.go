@@ -70,6 +70,20 @@ func (f *Fork) New(
 	}
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetPreviousVersion returns the last version before the fork.
+func (f *Fork) GetPreviousVersion() common.Version {
+	return f.PreviousVersion
+}
+
+// GetCurrentVersion returns the first version after the fork.
+func (f *Fork) GetCurrentVersion() common.Version {
+	return f.CurrentVersion
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -22,8 +22,10 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
@@ -38,6 +40,10 @@ type BeaconState[
 		StaticSSZField[ValidatorT, V],
 	P, F, V any,
 ] struct {
+	// forkVersion is the fork version the state is encoded for, which
+	// determines the fields from Electra.
+	forkVersion uint32
+
 	// Versioning
 	GenesisValidatorsRoot common.Root
 	Slot                  math.Slot
@@ -67,6 +73,10 @@ type BeaconState[
 	// Slashing
 	Slashings     []math.Gwei
 	TotalSlashing math.Gwei
+
+	// Electra
+	DepositRequestsStartIndex uint64
+	PendingDeposits           []*Deposit
 }
 
 // New creates a new BeaconState.
@@ -76,7 +86,7 @@ func (st *BeaconState[
 	ValidatorT,
 	P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -93,6 +103,8 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []math.Gwei,
 	totalSlashing math.Gwei,
+	depositRequestsStartIndex uint64,
+	pendingDeposits []*Deposit,
 ) (*BeaconState[
 	ExecutionPayloadHeaderT,
 	ForkT,
@@ -105,6 +117,7 @@ func (st *BeaconState[
 		ValidatorT,
 		P, F, V,
 	]{
+		forkVersion:                  forkVersion,
 		Slot:                         slot,
 		GenesisValidatorsRoot:        genesisValidatorsRoot,
 		Fork:                         fork,
@@ -121,6 +134,8 @@ func (st *BeaconState[
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		DepositRequestsStartIndex:    depositRequestsStartIndex,
+		PendingDeposits:              pendingDeposits,
 	}, nil
 }
 
@@ -133,6 +148,9 @@ func (st *BeaconState[
 	_, _, _, _, _, _,
 ]) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	var size uint32 = 300
+	if st.isElectra() {
+		size += 12
+	}
 
 	if fixed {
 		return size
@@ -146,6 +164,9 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(siz, st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(siz, st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(siz, st.Slashings)
+	if st.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(siz, st.PendingDeposits)
+	}
 
 	return size
 }
@@ -186,6 +207,14 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Electra
+	if st.isElectra() {
+		ssz.DefineUint64(codec, &st.DepositRequestsStartIndex)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.PendingDeposits, constants.PendingDepositsLimit,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -194,6 +223,11 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.PendingDeposits, constants.PendingDepositsLimit,
+		)
+	}
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -381,10 +415,42 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Field (16) 'DepositRequestsStartIndex'
+	if st.isElectra() {
+		hh.PutUint64(st.DepositRequestsStartIndex)
+
+		// Field (17) 'PendingDeposits'
+		subIndx = hh.Index()
+		num = uint64(len(st.PendingDeposits))
+		if num > constants.PendingDepositsLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.PendingDeposits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.PendingDepositsLimit)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
 
+// Version returns the fork version the state is encoded for.
+func (st *BeaconState[
+	_, _, _, _, _, _,
+]) Version() uint32 {
+	return st.forkVersion
+}
+
+// isElectra returns whether the state has the Electra fields.
+func (st *BeaconState[
+	_, _, _, _, _, _,
+]) isElectra() bool {
+	return st.forkVersion >= version.Electra
+}
+
 // GetTree ssz hashes the BeaconState object.
 func (st *BeaconState[
 	_, _, _, _, _, _,
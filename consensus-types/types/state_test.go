@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -223,3 +224,49 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		"HashTreeRoot and HashSequential should produce the same result",
 	)
 }
+
+func TestBeaconStateElectra(t *testing.T) {
+	deneb := generateValidBeaconState()
+	electra, err := deneb.New(
+		version.Electra,
+		deneb.GenesisValidatorsRoot,
+		deneb.Slot,
+		deneb.Fork,
+		deneb.LatestBlockHeader,
+		deneb.BlockRoots,
+		deneb.StateRoots,
+		deneb.Eth1Data,
+		deneb.Eth1DepositIndex,
+		deneb.LatestExecutionPayloadHeader,
+		deneb.Validators,
+		deneb.Balances,
+		deneb.RandaoMixes,
+		deneb.NextWithdrawalIndex,
+		deneb.NextWithdrawalValidatorIndex,
+		deneb.Slashings,
+		deneb.TotalSlashing,
+		7,
+		[]*types.Deposit{{
+			Pubkey:      [48]byte{0x38},
+			Credentials: [32]byte{0x39},
+			Amount:      32000000000,
+			Index:       9,
+		}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, electra.Version())
+
+	// The deposit requests start index and the pending deposits are appended
+	// to the Deneb encoding.
+	denebBz, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+	electraBz, err := electra.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, electraBz, len(denebBz)+8+4+192)
+	require.NotEqual(t, deneb.HashTreeRoot(), electra.HashTreeRoot())
+
+	// The fastssz tree agrees with the karalabe root.
+	tree, err := electra.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, electra.HashTreeRoot(), common.Root(tree.Hash()))
+}
@@ -271,8 +271,8 @@ func (v Validator) IsFullyWithdrawable(
 	balance math.Gwei,
 	epoch math.Epoch,
 ) bool {
-	return v.HasEth1WithdrawalCredentials() && v.WithdrawableEpoch <= epoch &&
-		balance > 0
+	return v.HasExecutionWithdrawalCredential() &&
+		v.WithdrawableEpoch <= epoch && balance > 0
 }
 
 // IsPartiallyWithdrawable as defined in the Ethereum 2.0 specification:
@@ -281,7 +281,7 @@ func (v Validator) IsPartiallyWithdrawable(
 	balance, maxEffectiveBalance math.Gwei,
 ) bool {
 	hasExcessBalance := balance > maxEffectiveBalance
-	return v.HasEth1WithdrawalCredentials() &&
+	return v.HasExecutionWithdrawalCredential() &&
 		v.HasMaxEffectiveBalance(maxEffectiveBalance) && hasExcessBalance
 }
 
@@ -291,6 +291,27 @@ func (v Validator) HasEth1WithdrawalCredentials() bool {
 	return v.WithdrawalCredentials[0] == EthSecp256k1CredentialPrefix
 }
 
+// HasCompoundingWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_compounding_withdrawal_credential
+func (v Validator) HasCompoundingWithdrawalCredential() bool {
+	return v.WithdrawalCredentials.IsCompounding()
+}
+
+// HasExecutionWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+func (v Validator) HasExecutionWithdrawalCredential() bool {
+	return v.HasEth1WithdrawalCredentials() ||
+		v.HasCompoundingWithdrawalCredential()
+}
+
+// SwitchToCompoundingWithdrawalCredential sets the compounding prefix on the
+// withdrawal credentials of the validator, keeping its withdrawal address.
+func (v *Validator) SwitchToCompoundingWithdrawalCredential() {
+	v.WithdrawalCredentials[0] = CompoundingCredentialPrefix
+}
+
 // HasMaxEffectiveBalance determines if the validator has the maximum effective
 // balance.
 func (v Validator) HasMaxEffectiveBalance(
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// ValidatorRegistration represents the registration of the payload
+// preferences of a validator, as defined in the builder specification.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#validatorregistrationv1
+type ValidatorRegistration struct {
+	// FeeRecipient is the address receiving the fees of the payloads built
+	// for the validator.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the target gas limit of the payloads built for the
+	// validator.
+	GasLimit math.U64 `json:"gas_limit"`
+	// Timestamp is the time of the registration.
+	Timestamp math.U64 `json:"timestamp"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ValidatorRegistration object in SSZ
+// encoding.
+func (*ValidatorRegistration) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 20 + 8 + 8 + 48 = 84.
+	return 84
+}
+
+// DefineSSZ defines the SSZ encoding for the ValidatorRegistration object.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ValidatorRegistration
+// object.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// MarshalSSZTo marshals the ValidatorRegistration object to SSZ format into
+// the provided buffer.
+func (r *ValidatorRegistration) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// MarshalSSZ marshals the ValidatorRegistration object to SSZ format.
+func (r *ValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(r))
+	return r.MarshalSSZTo(buf)
+}
+
+// UnmarshalSSZ unmarshals the ValidatorRegistration object from SSZ format.
+func (r *ValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// Verify verifies the signature of the registration by its validator. As in
+// the builder specification, registrations are signed over the application
+// builder domain.
+func (r *ValidatorRegistration) Verify(
+	forkData *ForkData,
+	signature crypto.BLSSignature,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		r, forkData.ComputeDomain(domainType))
+	if err := signatureVerificationFn(
+		r.Pubkey, signingRoot[:], signature,
+	); err != nil {
+		return errors.Join(err, ErrValidatorRegistration)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorRegistration_MarshalUnmarshalSSZ(t *testing.T) {
+	original := &types.ValidatorRegistration{
+		FeeRecipient: common.ExecutionAddress{0x01},
+		GasLimit:     math.U64(30_000_000),
+		Timestamp:    math.U64(1_700_000_000),
+		Pubkey:       crypto.BLSPubkey{0x02},
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, 84)
+
+	var unmarshalled types.ValidatorRegistration
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, &unmarshalled)
+	require.Equal(t, original.HashTreeRoot(), unmarshalled.HashTreeRoot())
+}
+
+func TestValidatorRegistration_Verify(t *testing.T) {
+	registration := &types.ValidatorRegistration{
+		GasLimit: math.U64(30_000_000),
+		Pubkey:   crypto.BLSPubkey{0x02},
+	}
+	forkData := types.NewForkData(common.Version{}, common.Root{})
+	domainType := common.DomainType{0x00, 0x00, 0x00, 0x01}
+	expectedRoot := types.ComputeSigningRoot(
+		registration, forkData.ComputeDomain(domainType),
+	)
+
+	verifyFn := func(
+		pubkey crypto.BLSPubkey, message []byte, _ crypto.BLSSignature,
+	) error {
+		if pubkey != registration.Pubkey ||
+			common.Root(message) != expectedRoot {
+			return errors.New("signature verification failed")
+		}
+		return nil
+	}
+	require.NoError(t, registration.Verify(
+		forkData, crypto.BLSSignature{}, domainType, verifyFn,
+	))
+
+	// Any change to the registration invalidates the signature.
+	registration.GasLimit++
+	err := registration.Verify(
+		forkData, crypto.BLSSignature{}, domainType, verifyFn,
+	)
+	require.ErrorIs(t, err, types.ErrValidatorRegistration)
+}
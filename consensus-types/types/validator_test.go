@@ -525,6 +525,69 @@ func TestValidator_HasEth1WithdrawalCredentials(t *testing.T) {
 	}
 }
 
+func TestValidator_HasCompoundingWithdrawalCredential(t *testing.T) {
+	address := common.ExecutionAddress{0x01}
+	tests := []struct {
+		name            string
+		credentials     types.WithdrawalCredentials
+		wantCompounding bool
+		wantExecution   bool
+	}{
+		{
+			name: "eth1 credentials",
+			credentials: types.NewCredentialsFromExecutionAddress(
+				address,
+			),
+			wantCompounding: false,
+			wantExecution:   true,
+		},
+		{
+			name: "compounding credentials",
+			credentials: types.NewCompoundingCredentialsFromExecutionAddress(
+				address,
+			),
+			wantCompounding: true,
+			wantExecution:   true,
+		},
+		{
+			name:            "bls credentials",
+			credentials:     types.WithdrawalCredentials{0x00},
+			wantCompounding: false,
+			wantExecution:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &types.Validator{WithdrawalCredentials: tt.credentials}
+			require.Equal(
+				t,
+				tt.wantCompounding,
+				v.HasCompoundingWithdrawalCredential(),
+			)
+			require.Equal(
+				t,
+				tt.wantExecution,
+				v.HasExecutionWithdrawalCredential(),
+			)
+		})
+	}
+}
+
+func TestValidator_SwitchToCompoundingWithdrawalCredential(t *testing.T) {
+	address := common.ExecutionAddress{0x01}
+	v := &types.Validator{
+		WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+			address,
+		),
+	}
+	v.SwitchToCompoundingWithdrawalCredential()
+	require.Equal(
+		t,
+		types.NewCompoundingCredentialsFromExecutionAddress(address),
+		v.GetWithdrawalCredentials(),
+	)
+}
+
 func TestValidator_HasMaxEffectiveBalance(t *testing.T) {
 	maxEffectiveBalance := math.Gwei(32e9)
 	tests := []struct {
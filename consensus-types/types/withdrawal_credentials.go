@@ -29,8 +29,13 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix = byte(iota + 1)
+	// CompoundingCredentialPrefix is the prefix for EIP-7251 compounding
+	// credentials, withdrawing to an Ethereum secp256k1 address.
+	CompoundingCredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
@@ -47,12 +52,28 @@ func NewCredentialsFromExecutionAddress(
 	return credentials
 }
 
+// NewCompoundingCredentialsFromExecutionAddress creates new compounding
+// WithdrawalCredentials from an execution address.
+func NewCompoundingCredentialsFromExecutionAddress(
+	address common.ExecutionAddress,
+) WithdrawalCredentials {
+	credentials := NewCredentialsFromExecutionAddress(address)
+	credentials[0] = CompoundingCredentialPrefix
+	return credentials
+}
+
+// IsCompounding returns true if the WithdrawalCredentials are compounding.
+func (wc WithdrawalCredentials) IsCompounding() bool {
+	return wc[0] == CompoundingCredentialPrefix
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 func (wc WithdrawalCredentials) ToExecutionAddress() (
 	common.ExecutionAddress,
 	error,
 ) {
-	if wc[0] != EthSecp256k1CredentialPrefix {
+	if wc[0] != EthSecp256k1CredentialPrefix &&
+		wc[0] != CompoundingCredentialPrefix {
 		return common.ExecutionAddress{}, ErrInvalidWithdrawalCredentials
 	}
 	return common.ExecutionAddress(wc[12:]), nil
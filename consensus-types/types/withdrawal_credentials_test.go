@@ -90,6 +90,19 @@ func TestToExecutionAddress(t *testing.T) {
 	)
 }
 
+func TestToExecutionAddress_Compounding(t *testing.T) {
+	expectedAddress := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	credentials := types.NewCompoundingCredentialsFromExecutionAddress(
+		expectedAddress,
+	)
+	require.Equal(t, types.CompoundingCredentialPrefix, credentials[0])
+	require.True(t, credentials.IsCompounding())
+
+	address, err := credentials.ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, expectedAddress, address)
+}
+
 func TestToExecutionAddress_InvalidPrefix(t *testing.T) {
 	credentials := types.WithdrawalCredentials{}
 	for i := range credentials {
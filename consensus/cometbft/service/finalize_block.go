@@ -24,18 +24,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/sourcegraph/conc/iter"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service[LoggerT]) finalizeBlock(
 	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
+	ctx, span := tracing.StartSpan(
+		ctx, "abci.FinalizeBlock", attribute.Int64("height", req.Height),
+	)
 	res, err := s.finalizeBlockInternal(ctx, req)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
+	tracing.EndSpan(span, err)
 	return res, err
 }
 
@@ -53,6 +59,10 @@ func (s *Service[LoggerT]) finalizeBlockInternal(
 	// CometBFT.
 	if s.finalizeBlockState == nil {
 		s.finalizeBlockState = s.resetState(ctx)
+	} else {
+		s.finalizeBlockState.SetContext(
+			s.finalizeBlockState.Context().WithContext(ctx),
+		)
 	}
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
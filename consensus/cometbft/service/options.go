@@ -24,6 +24,8 @@ import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -81,3 +83,46 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetCustomReactor registers an additional reactor with the p2p switch of the
+// node under the given name.
+func SetCustomReactor[
+	LoggerT log.AdvancedLogger[LoggerT],
+](name string, reactor p2p.Reactor) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		if s.customReactors == nil {
+			s.customReactors = make(map[string]p2p.Reactor)
+		}
+		s.customReactors[name] = reactor
+	}
+}
+
+// SetPrivValidator sets the CometBFT private validator of the node, used
+// instead of the one of the private validator key file.
+func SetPrivValidator[
+	LoggerT log.AdvancedLogger[LoggerT],
+](pv types.PrivValidator) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.privValidator = pv
+	}
+}
+
+// SetHostedPrivValidators sets the private validators hosted by the node in
+// addition to its own CometBFT private validator.
+func SetHostedPrivValidators[
+	LoggerT log.AdvancedLogger[LoggerT],
+](pvs []types.PrivValidator) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.hostedPrivValidators = pvs
+	}
+}
+
+// SetPrivValidatorWrapper sets the function wrapping every CometBFT private
+// validator of the node before it signs, e.g. with slashing protection.
+func SetPrivValidatorWrapper[
+	LoggerT log.AdvancedLogger[LoggerT],
+](wrap func(types.PrivValidator) types.PrivValidator) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.wrapPrivValidator = wrap
+	}
+}
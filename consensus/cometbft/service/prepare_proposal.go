@@ -27,8 +27,10 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service[LoggerT]) prepareProposal(
@@ -39,6 +41,11 @@ func (s *Service[LoggerT]) prepareProposal(
 	defer s.telemetrySink.MeasureSince(
 		"beacon_kit.runtime.prepare_proposal_duration", startTime)
 
+	ctx, span := tracing.StartSpan(
+		ctx, "abci.PrepareProposal", attribute.Int64("height", req.Height),
+	)
+	defer span.End()
+
 	// CometBFT must never call PrepareProposal with a height of 0.
 	if req.Height < 1 {
 		return nil, fmt.Errorf(
@@ -75,6 +82,7 @@ func (s *Service[LoggerT]) prepareProposal(
 		*slotData,
 	)
 	if err != nil {
+		span.RecordError(err)
 		s.logger.Error(
 			"failed to prepare proposal",
 			"height",
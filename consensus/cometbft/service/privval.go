@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/berachain/beacon-kit/log"
+	dbm "github.com/cometbft/cometbft-db"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// stateDBID is the ID of the CometBFT state database.
+const stateDBID = "state"
+
+var (
+	errNoPrivValidator      = errors.New("no private validator to host")
+	errUnknownPrivValidator = errors.New(
+		"vote of a validator not hosted by this node",
+	)
+	errNoConsensusState = errors.New(
+		"consensus state does not accept votes",
+	)
+	errHostedWithSocketSigner = errors.New(
+		"hosted validators cannot be used with priv_validator_laddr",
+	)
+)
+
+// VoteAdder is the consensus state of the node, which the votes of the
+// hosted validators are added to.
+type VoteAdder interface {
+	AddVote(vote *types.Vote, peerID p2p.ID) (bool, error)
+}
+
+// HostedPrivValidator is the CometBFT private validator of a node hosting
+// several validators. CometBFT reads the public key of its private validator
+// once per height, so the key returned is the one of the hosted validator
+// proposing the first round of the height, if any, and otherwise the one of
+// a hosted validator in the validator set. CometBFT proposes and votes with
+// that key, and every vote it casts is cast as well by the other hosted
+// validators in the validator set. Hosted validators proposing a later round
+// of a height are not selected, so those rounds time out.
+type HostedPrivValidator struct {
+	mu sync.Mutex
+	// pvs are the private validators of the hosted validators.
+	pvs []types.PrivValidator
+	// addresses are the addresses of pvs.
+	addresses [][]byte
+	// active is the index of the private validator CometBFT acts as.
+	active int
+	// logger is the logger for the votes of the hosted validators.
+	logger log.Logger
+	// stateStore is the CometBFT state store, read for the validator set of
+	// the height voted. It is nil until the node opens its state database.
+	stateStore sm.Store
+	// consensus is the consensus state the votes of the hosted validators
+	// are added to. It is nil until the node is connected.
+	consensus VoteAdder
+}
+
+// NewHostedPrivValidator creates a private validator hosting pvs, the first
+// one being the private validator of the node.
+func NewHostedPrivValidator(
+	logger log.Logger,
+	pvs ...types.PrivValidator,
+) (*HostedPrivValidator, error) {
+	if len(pvs) == 0 {
+		return nil, errNoPrivValidator
+	}
+	pv := &HostedPrivValidator{
+		pvs:       pvs,
+		addresses: make([][]byte, len(pvs)),
+		logger:    logger,
+	}
+	for i, hosted := range pvs {
+		pubKey, err := hosted.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pv.addresses[i] = pubKey.Address()
+	}
+	return pv, nil
+}
+
+// GetPubKey selects the hosted validator CometBFT acts as for the next
+// height and returns its public key. CometBFT calls it once the previous
+// height is committed.
+func (pv *HostedPrivValidator) GetPubKey() (crypto.PubKey, error) {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	pv.active = pv.selectActive()
+	return pv.pvs[pv.active].GetPubKey()
+}
+
+// selectActive returns the index of the hosted validator proposing the first
+// round of the next height, or else of the first hosted validator in its
+// validator set, defaulting to the private validator of the node.
+func (pv *HostedPrivValidator) selectActive() int {
+	if pv.stateStore == nil {
+		return 0
+	}
+	state, err := pv.stateStore.Load()
+	if err != nil || state.IsEmpty() {
+		return 0
+	}
+	if i := pv.indexOf(state.Validators.GetProposer().Address); i >= 0 {
+		return i
+	}
+	for i, address := range pv.addresses {
+		if state.Validators.HasAddress(address) {
+			return i
+		}
+	}
+	return 0
+}
+
+// indexOf returns the index of the hosted validator with the given address,
+// -1 if it is not hosted.
+func (pv *HostedPrivValidator) indexOf(address []byte) int {
+	for i, hosted := range pv.addresses {
+		if string(hosted) == string(address) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SignVote signs the vote with the hosted validator it is cast by, and casts
+// the same vote with the other hosted validators in the validator set.
+func (pv *HostedPrivValidator) SignVote(
+	chainID string, vote *cmtproto.Vote, signExtension bool,
+) error {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	signer := pv.indexOf(vote.GetValidatorAddress())
+	if signer < 0 {
+		return errUnknownPrivValidator
+	}
+	if err := pv.pvs[signer].SignVote(chainID, vote, signExtension); err != nil {
+		return err
+	}
+	if pv.consensus == nil || pv.stateStore == nil || len(pv.pvs) == 1 {
+		return nil
+	}
+
+	validators, err := pv.stateStore.LoadValidators(vote.GetHeight())
+	if err != nil {
+		pv.logger.Error(
+			"Failed to load validators to vote with hosted validators",
+			"height", vote.GetHeight(), "error", err,
+		)
+		return nil
+	}
+	votes := make([]*types.Vote, 0, len(pv.pvs)-1)
+	for i := range pv.pvs {
+		index, _ := validators.GetByAddress(pv.addresses[i])
+		if i == signer || index < 0 {
+			continue
+		}
+		hosted := *vote
+		hosted.ValidatorAddress = pv.addresses[i]
+		hosted.ValidatorIndex = index
+		hosted.Signature = nil
+		hosted.ExtensionSignature = nil
+		if err = pv.pvs[i].SignVote(
+			chainID, &hosted, signExtension,
+		); err != nil {
+			pv.logger.Error(
+				"Failed to sign vote with hosted validator",
+				"validator_index", index, "error", err,
+			)
+			continue
+		}
+		v, vErr := types.VoteFromProto(&hosted)
+		if vErr != nil {
+			return vErr
+		}
+		votes = append(votes, v)
+	}
+
+	// CometBFT signs votes from its consensus routine, which consumes the
+	// votes added, so they are added in the background.
+	consensus := pv.consensus
+	go func() {
+		for _, v := range votes {
+			//#nosec:G104 // AddVote only queues the vote.
+			_, _ = consensus.AddVote(v, "")
+		}
+	}()
+	return nil
+}
+
+// SignProposal signs the proposal with the hosted validator CometBFT acts
+// as, which CometBFT only proposes with when it is the proposer.
+func (pv *HostedPrivValidator) SignProposal(
+	chainID string, proposal *cmtproto.Proposal,
+) error {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	return pv.pvs[pv.active].SignProposal(chainID, proposal)
+}
+
+// SignBytes signs bytes with the hosted validator CometBFT acts as.
+func (pv *HostedPrivValidator) SignBytes(bytes []byte) ([]byte, error) {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	return pv.pvs[pv.active].SignBytes(bytes)
+}
+
+// DBProvider wraps provider to keep the state database the node opens, from
+// which the validator set of the height voted is read.
+func (pv *HostedPrivValidator) DBProvider(
+	cfg *cmtcfg.Config, provider cmtcfg.DBProvider,
+) cmtcfg.DBProvider {
+	return func(ctx *cmtcfg.DBContext) (dbm.DB, error) {
+		db, err := provider(ctx)
+		if err != nil || ctx.ID != stateDBID {
+			return db, err
+		}
+		pv.mu.Lock()
+		defer pv.mu.Unlock()
+		pv.stateStore = sm.NewStore(db, sm.StoreOptions{
+			DBKeyLayout: cfg.Storage.ExperimentalKeyLayout,
+		})
+		return db, nil
+	}
+}
+
+// ConnectConsensus connects the private validator to the consensus state
+// the votes of the other hosted validators are added to. It must be called
+// before the node starts.
+func (pv *HostedPrivValidator) ConnectConsensus(consensus VoteAdder) {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	pv.consensus = consensus
+}
+
+// consensusState returns the consensus state of n.
+func consensusState(n *node.Node) (VoteAdder, error) {
+	env, err := n.ConfigureRPC()
+	if err != nil {
+		return nil, err
+	}
+	consensus, ok := env.ConsensusState.(VoteAdder)
+	if !ok {
+		return nil, errNoConsensusState
+	}
+	return consensus, nil
+}
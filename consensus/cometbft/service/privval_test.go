@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"testing"
+	"time"
+
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log/noop"
+	dbm "github.com/cometbft/cometbft-db"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "hosted-test"
+
+// voteRecorder records the votes added to the consensus state.
+type voteRecorder struct {
+	votes chan *types.Vote
+}
+
+func (r *voteRecorder) AddVote(vote *types.Vote, _ p2p.ID) (bool, error) {
+	r.votes <- vote
+	return true, nil
+}
+
+// hostedFixture hosts pvs, the validators of which are saved at height 1
+// with the given voting powers, a zero power leaving it out of the set.
+func hostedFixture(
+	t *testing.T, powers []int64, pvs ...types.PrivValidator,
+) (*cometbft.HostedPrivValidator, *types.ValidatorSet, *voteRecorder) {
+	t.Helper()
+	hosted, err := cometbft.NewHostedPrivValidator(
+		noop.NewLogger[any](), pvs...,
+	)
+	require.NoError(t, err)
+
+	cfg := cmtcfg.DefaultConfig()
+	db := dbm.NewMemDB()
+	provider := hosted.DBProvider(
+		cfg, func(*cmtcfg.DBContext) (dbm.DB, error) { return db, nil },
+	)
+	_, err = provider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+
+	vals := make([]*types.Validator, 0, len(pvs))
+	for i, pv := range pvs {
+		if powers[i] == 0 {
+			continue
+		}
+		pubKey, pkErr := pv.GetPubKey()
+		require.NoError(t, pkErr)
+		vals = append(vals, types.NewValidator(pubKey, powers[i]))
+	}
+	set := types.NewValidatorSet(vals)
+	store := sm.NewStore(db, sm.StoreOptions{
+		DBKeyLayout: cfg.Storage.ExperimentalKeyLayout,
+	})
+	require.NoError(t, store.Save(sm.State{
+		ChainID:         chainID,
+		InitialHeight:   1,
+		Validators:      set,
+		NextValidators:  set.CopyIncrementProposerPriority(1),
+		LastValidators:  types.NewValidatorSet(nil),
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}))
+
+	recorder := &voteRecorder{votes: make(chan *types.Vote, len(pvs))}
+	hosted.ConnectConsensus(recorder)
+	return hosted, set, recorder
+}
+
+func prevote(t *testing.T, pv types.PrivValidator, height int64) *cmtproto.Vote {
+	t.Helper()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	return &cmtproto.Vote{
+		Type:             types.PrevoteType,
+		Height:           height,
+		Timestamp:        time.Now(),
+		ValidatorAddress: pubKey.Address(),
+	}
+}
+
+func TestHostedPrivValidator_SignVoteClonesInSet(t *testing.T) {
+	node, inSet := types.NewMockPV(), types.NewMockPV()
+	notInSet, failing := types.NewMockPV(), types.NewErroringMockPV()
+	hosted, set, recorder := hostedFixture(
+		t, []int64{10, 10, 0, 10}, node, inSet, notInSet, failing,
+	)
+
+	vote := prevote(t, node, 1)
+	require.NoError(t, hosted.SignVote(chainID, vote, false))
+	require.NotEmpty(t, vote.GetSignature())
+
+	// Only the vote of the hosted validator in the set that signs is added.
+	var added *types.Vote
+	select {
+	case added = <-recorder.votes:
+	case <-time.After(time.Second):
+		t.Fatal("no vote added for the hosted validator")
+	}
+	select {
+	case v := <-recorder.votes:
+		t.Fatalf("unexpected vote of %X", v.ValidatorAddress)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	pubKey, err := inSet.GetPubKey()
+	require.NoError(t, err)
+	index, _ := set.GetByAddress(pubKey.Address())
+	require.Equal(t, pubKey.Address(), added.ValidatorAddress)
+	require.Equal(t, index, added.ValidatorIndex)
+	require.Equal(t, vote.GetHeight(), added.Height)
+	require.NoError(t, added.Verify(chainID, pubKey))
+}
+
+func TestHostedPrivValidator_SignVoteWithoutValidators(t *testing.T) {
+	node, inSet := types.NewMockPV(), types.NewMockPV()
+	hosted, _, recorder := hostedFixture(t, []int64{10, 10}, node, inSet)
+
+	// No validators are stored for the height, so only the vote of the
+	// node is signed.
+	vote := prevote(t, node, 5)
+	require.NoError(t, hosted.SignVote(chainID, vote, false))
+	require.NotEmpty(t, vote.GetSignature())
+	select {
+	case v := <-recorder.votes:
+		t.Fatalf("unexpected vote of %X", v.ValidatorAddress)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHostedPrivValidator_SignVoteUnknownValidator(t *testing.T) {
+	hosted, _, _ := hostedFixture(t, []int64{10}, types.NewMockPV())
+	require.Error(t, hosted.SignVote(
+		chainID, prevote(t, types.NewMockPV(), 1), false,
+	))
+}
+
+func TestHostedPrivValidator_ActsAsProposer(t *testing.T) {
+	node, proposer := types.NewMockPV(), types.NewMockPV()
+	hosted, set, recorder := hostedFixture(
+		t, []int64{10, 100}, node, proposer,
+	)
+
+	pubKey, err := hosted.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, set.GetProposer().Address, pubKey.Address())
+
+	// The proposer casts the vote, and the node votes along.
+	vote := prevote(t, proposer, 1)
+	require.NoError(t, hosted.SignVote(chainID, vote, false))
+	select {
+	case added := <-recorder.votes:
+		nodeKey, nkErr := node.GetPubKey()
+		require.NoError(t, nkErr)
+		require.NoError(t, added.Verify(chainID, nodeKey))
+	case <-time.After(time.Second):
+		t.Fatal("no vote added for the node")
+	}
+
+	proposal := &cmtproto.Proposal{
+		Type: types.ProposalType, Height: 1, Timestamp: time.Now(),
+	}
+	require.NoError(t, hosted.SignProposal(chainID, proposal))
+	require.True(t, pubKey.VerifySignature(
+		types.ProposalSignBytes(chainID, proposal), proposal.GetSignature(),
+	))
+}
+
+func TestHostedPrivValidator_ActsAsValidatorInSet(t *testing.T) {
+	notInSet, inSet := types.NewMockPV(), types.NewMockPV()
+	hosted, _, _ := hostedFixture(t, []int64{0, 10}, notInSet, inSet)
+
+	pubKey, err := hosted.GetPubKey()
+	require.NoError(t, err)
+	expected, err := inSet.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, expected.Address(), pubKey.Address())
+}
@@ -25,7 +25,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service[LoggerT]) processProposal(
@@ -57,6 +59,16 @@ func (s *Service[LoggerT]) processProposal(
 		s.finalizeBlockState = s.resetState(ctx)
 	}
 
+	// Only the proposal state carries the span, finalizeBlockState is
+	// re-parented in finalizeBlock.
+	spanCtx, span := tracing.StartSpan(
+		ctx, "abci.ProcessProposal", attribute.Int64("height", req.Height),
+	)
+	defer span.End()
+	s.processProposalState.SetContext(
+		s.processProposalState.Context().WithContext(spanCtx),
+	)
+
 	s.processProposalState.SetContext(
 		s.getContextForProposal(
 			s.processProposalState.Context(),
@@ -69,6 +81,7 @@ func (s *Service[LoggerT]) processProposal(
 		req,
 	)
 	if err != nil {
+		span.RecordError(err)
 		s.logger.Error(
 			"failed to process proposal",
 			"height",
@@ -42,6 +42,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -90,6 +91,17 @@ type Service[
 	minRetainBlocks uint64
 
 	chainID string
+
+	// customReactors are registered with the p2p switch of the node.
+	customReactors map[string]p2p.Reactor
+	// privValidator, if set, is the private validator of the node, used
+	// instead of the one of the private validator key file.
+	privValidator cmttypes.PrivValidator
+	// hostedPrivValidators are the private validators hosted by the node in
+	// addition to its own.
+	hostedPrivValidators []cmttypes.PrivValidator
+	// wrapPrivValidator, if set, wraps every private validator of the node.
+	wrapPrivValidator func(cmttypes.PrivValidator) cmttypes.PrivValidator
 }
 
 func NewService[
@@ -146,23 +158,63 @@ func (s *Service[_]) Start(
 		return err
 	}
 
+	var (
+		privValidator = s.privValidator
+		dbProvider    = cmtcfg.DefaultDBProvider
+		hostedPVs     = s.hostedPrivValidators
+		hosted        *HostedPrivValidator
+	)
+	if privValidator == nil {
+		privValidator = pvm.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+		)
+	}
+	if s.wrapPrivValidator != nil {
+		privValidator = s.wrapPrivValidator(privValidator)
+		hostedPVs = make([]cmttypes.PrivValidator, len(s.hostedPrivValidators))
+		for i, pv := range s.hostedPrivValidators {
+			hostedPVs[i] = s.wrapPrivValidator(pv)
+		}
+	}
+	if len(hostedPVs) > 0 {
+		// CometBFT signs with the remote signer instead, which knows
+		// nothing of the hosted validators.
+		if cfg.PrivValidatorListenAddr != "" {
+			return errHostedWithSocketSigner
+		}
+		hosted, err = NewHostedPrivValidator(s.logger, append(
+			[]cmttypes.PrivValidator{privValidator}, hostedPVs...,
+		)...)
+		if err != nil {
+			return err
+		}
+		privValidator = hosted
+		dbProvider = hosted.DBProvider(cfg, dbProvider)
+	}
+
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
-		pvm.LoadOrGenFilePV(
-			cfg.PrivValidatorKeyFile(),
-			cfg.PrivValidatorStateFile(),
-		),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
-		cmtcfg.DefaultDBProvider,
+		dbProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
+		node.CustomReactors(s.customReactors),
 	)
 	if err != nil {
 		return err
 	}
+	if hosted != nil {
+		var consensus VoteAdder
+		if consensus, err = consensusState(s.node); err != nil {
+			return err
+		}
+		hosted.ConnectConsensus(consensus)
+	}
 
 	return s.node.Start()
 }
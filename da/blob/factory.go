@@ -26,6 +26,8 @@ import (
 	"github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/merkle"
 	"golang.org/x/sync/errgroup"
@@ -109,6 +111,28 @@ func (f *SidecarFactory[BeaconBlockT, _]) BuildSidecars(
 	return &types.BlobSidecars{Sidecars: sidecars}, g.Wait()
 }
 
+// BuildDataColumnSidecars builds the data column sidecars of a block from
+// the cells and cell proofs of each of its blobs, in commitment order.
+func (f *SidecarFactory[BeaconBlockT, _]) BuildDataColumnSidecars(
+	blk BeaconBlockT,
+	cells [][]eip7594.Cell,
+	proofs [][]eip4844.KZGProof,
+) ([]*types.DataColumnSidecar, error) {
+	body := blk.GetBody()
+	// The columns share the proof of the commitments in the body.
+	inclusionProof, err := f.BuildBlockBodyProof(body)
+	if err != nil {
+		return nil, err
+	}
+	return types.BuildDataColumnSidecars(
+		blk.GetHeader(),
+		body.GetBlobKzgCommitments(),
+		cells,
+		proofs,
+		inclusionProof,
+	)
+}
+
 // BuildKZGInclusionProof builds a KZG inclusion proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT]) BuildKZGInclusionProof(
 	body BeaconBlockBodyT,
@@ -21,6 +21,7 @@
 package blob
 
 import (
+	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/da/kzg"
@@ -92,6 +93,7 @@ func NewProcessor[
 func (sp *Processor[
 	AvailabilityStoreT, _, ConsensusSidecarsT, _, _,
 ]) VerifySidecars(
+	ctx context.Context,
 	cs ConsensusSidecarsT,
 ) error {
 	var (
@@ -116,7 +118,7 @@ func (sp *Processor[
 
 	// Verify the blobs and ensure they match the local state.
 	return sp.verifier.verifySidecars(
-		sidecars, kzgOffset, blkHeader,
+		ctx, sidecars, kzgOffset, blkHeader,
 	)
 }
 
@@ -27,7 +27,9 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/kzg"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -60,15 +62,23 @@ func newVerifier[
 // verifySidecars verifies the blobs for both inclusion as well
 // as the KZG proofs.
 func (bv *verifier[_, BlobSidecarsT]) verifySidecars(
+	ctx context.Context,
 	sidecars BlobSidecarsT,
 	kzgOffset uint64,
 	blkHeader *ctypes.BeaconBlockHeader,
-) error {
+) (err error) {
 	defer bv.metrics.measureVerifySidecarsDuration(
 		time.Now(), math.U64(sidecars.Len()),
 		bv.proofVerifier.GetImplementation(),
 	)
 
+	ctx, span := tracing.StartSpan(
+		ctx, "blob.VerifySidecars",
+		attribute.Int("num_sidecars", sidecars.Len()),
+		attribute.String("kzg_impl", bv.proofVerifier.GetImplementation()),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// check that sideracs block headers match with header of the
 	// corresponding block
 	for i, s := range sidecars.GetSidecars() {
@@ -82,14 +92,20 @@ func (bv *verifier[_, BlobSidecarsT]) verifySidecars(
 	g.Go(func() error {
 		// TODO: KZGOffset needs to be configurable and not
 		// passed in.
-		return bv.verifyInclusionProofs(
-			sidecars, kzgOffset,
+		_, inclusionSpan := tracing.StartSpan(
+			ctx, "blob.verifyInclusionProofs",
 		)
+		inclusionErr := bv.verifyInclusionProofs(sidecars, kzgOffset)
+		tracing.EndSpan(inclusionSpan, inclusionErr)
+		return inclusionErr
 	})
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyKZGProofs(sidecars)
+		_, kzgSpan := tracing.StartSpan(ctx, "blob.verifyKZGProofs")
+		kzgErr := bv.verifyKZGProofs(sidecars)
+		tracing.EndSpan(kzgSpan, kzgErr)
+		return kzgErr
 	})
 
 	g.Go(func() error {
@@ -21,6 +21,8 @@
 package da
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 )
 
@@ -36,7 +38,7 @@ type BlobProcessor[
 		sidecars BlobSidecarsT,
 	) error
 	// VerifySidecars verifies the blobs and ensures they match the local state.
-	VerifySidecars(sidecars ConsensusSidecarsT) error
+	VerifySidecars(ctx context.Context, sidecars ConsensusSidecarsT) error
 }
 
 type ConsensusSidecars[BlobSidecarsT any] interface {
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import "time"
+
+const (
+	// defaultFetchTimeout is the default deadline for sidecars to arrive.
+	defaultFetchTimeout = time.Second
+)
+
+// Config is the configuration of the sidecar gossip.
+type Config struct {
+	// Enabled makes the proposer gossip its sidecars on the sidecar channel
+	// and leave them out of the proposal, which then only carries the KZG
+	// commitments. Every node receives gossiped sidecars regardless.
+	Enabled bool `mapstructure:"enabled"`
+	// FetchTimeout is how long a node waits for the sidecars of a proposal
+	// that does not carry them before rejecting it.
+	FetchTimeout time.Duration `mapstructure:"fetch-timeout"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:      false,
+		FetchTimeout: defaultFetchTimeout,
+	}
+}
@@ -0,0 +1,441 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+const (
+	// ReactorName is the name the reactor is registered with in the switch.
+	ReactorName = "SIDECARS"
+	// ChannelID is the p2p channel sidecars are exchanged on.
+	ChannelID = byte(0x70)
+
+	// msgSidecars carries the sidecars of a block.
+	msgSidecars byte = 1
+	// msgRequest asks peers for the sidecars of a block.
+	msgRequest byte = 2
+
+	// headerSize is the size of the message type, slot and block root that
+	// prefix every message.
+	headerSize = 1 + 8 + 32
+	// cacheSize is the number of blocks whose verified sidecars are kept in
+	// memory.
+	cacheSize = 64
+	// pendingPerPeer is the number of blocks whose sidecars are kept, until
+	// verified, for each peer that sent them ahead of the block.
+	pendingPerPeer = 4
+	// maxMessageSize bounds the size of a received message.
+	maxMessageSize = 16 << 20
+	// requestInterval is how long a peer is given to answer a request before
+	// the next peer is asked.
+	requestInterval = 250 * time.Millisecond
+)
+
+// Source returns the SSZ encoded sidecars stored for a slot along with the
+// root of the block they belong to, so that the reactor can serve peers
+// catching up on blocks it already finalized.
+type Source func(slot uint64) ([]byte, common.Root, error)
+
+// key identifies the sidecars of a block.
+type key struct {
+	slot uint64
+	root common.Root
+}
+
+// pending holds the SSZ encoded sidecars of a block sent by a peer before
+// the block was processed, and therefore not verified yet.
+type pending struct {
+	key      key
+	sidecars []byte
+	from     p2p.Peer
+}
+
+// waiter is a Fetch waiting for the sidecars of a block.
+type waiter struct {
+	ch chan []byte
+	// verify checks that sidecars are the ones of the block.
+	verify func(sidecars []byte) error
+}
+
+// Reactor exchanges blob sidecars with peers on a dedicated channel, so that
+// they do not have to travel inside the CometBFT proposal.
+type Reactor struct {
+	p2p.BaseReactor
+
+	// logger is used for logging.
+	logger log.Logger
+	// source serves sidecars that are no longer cached, if set.
+	source Source
+	// fetchTimeout bounds how long Fetch waits for sidecars.
+	fetchTimeout time.Duration
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// cache holds the verified sidecars of recent blocks. Only verified
+	// sidecars are returned by Fetch and served to peers.
+	cache map[key][]byte
+	// order is the insertion order of cache, oldest first.
+	order []key
+	// pending holds the sidecars sent by each peer ahead of their block,
+	// oldest first, apart from cache so that peers cannot evict it.
+	pending map[p2p.ID][]pending
+	// waiters are notified when the sidecars of a block arrive.
+	waiters map[key][]*waiter
+}
+
+// NewReactor creates a new Reactor.
+func NewReactor(cfg Config, logger log.Logger, source Source) *Reactor {
+	if cfg.FetchTimeout <= 0 {
+		cfg.FetchTimeout = defaultFetchTimeout
+	}
+	r := &Reactor{
+		logger:       logger,
+		source:       source,
+		fetchTimeout: cfg.FetchTimeout,
+		cache:        make(map[key][]byte),
+		pending:      make(map[p2p.ID][]pending),
+		waiters:      make(map[key][]*waiter),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("Sidecars", r)
+	return r
+}
+
+// GetChannels implements p2p.Reactor.
+func (r *Reactor) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{{
+		ID:                  ChannelID,
+		Priority:            5,  //nolint:mnd // same as block parts.
+		SendQueueCapacity:   10, //nolint:mnd // a few blocks.
+		RecvMessageCapacity: maxMessageSize,
+		MessageType:         &gogotypes.BytesValue{},
+	}}
+}
+
+// Publish stores the SSZ encoded sidecars of the block with the given slot
+// and root and gossips them to every peer.
+func (r *Reactor) Publish(slot uint64, root common.Root, sidecars []byte) {
+	k := key{slot: slot, root: root}
+	r.put(k, sidecars)
+	r.broadcast(encodeMessage(msgSidecars, k, sidecars))
+}
+
+// Fetch returns the SSZ encoded sidecars of the block with the given slot
+// and root, requesting them from one peer after the other until sidecars
+// passing verify arrive, ctx is done or the fetch timeout expires. Peers
+// sending sidecars that fail verification are disconnected.
+func (r *Reactor) Fetch(
+	ctx context.Context,
+	slot uint64,
+	root common.Root,
+	verify func(sidecars []byte) error,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.fetchTimeout)
+	defer cancel()
+
+	k := key{slot: slot, root: root}
+	w := &waiter{ch: make(chan []byte, 1), verify: verify}
+	r.mu.Lock()
+	sidecars, found := r.cache[k]
+	gossiped := r.takePending(k)
+	r.waiters[k] = append(r.waiters[k], w)
+	r.mu.Unlock()
+	defer r.removeWaiter(k, w)
+
+	if found {
+		return sidecars, nil
+	}
+	// Sidecars gossiped before the block was processed are verified now.
+	for _, p := range gossiped {
+		if r.accept(k, p.sidecars, p.from, verify) {
+			return p.sidecars, nil
+		}
+	}
+
+	ticker := time.NewTicker(requestInterval)
+	defer ticker.Stop()
+	for attempt := k.slot; ; attempt++ {
+		r.request(k, attempt)
+		select {
+		case sidecars := <-w.ch:
+			return sidecars, nil
+		case <-ctx.Done():
+			return nil, errors.Wrapf(
+				ErrSidecarsUnavailable, "slot %d, root %s: %v",
+				slot, root, ctx.Err(),
+			)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Receive implements p2p.Reactor.
+func (r *Reactor) Receive(e p2p.Envelope) {
+	msg, ok := e.Message.(*gogotypes.BytesValue)
+	if !ok {
+		r.stopPeer(e.Src, ErrInvalidMessage)
+		return
+	}
+	msgType, k, payload, err := decodeMessage(msg.Value)
+	if err != nil {
+		r.stopPeer(e.Src, err)
+		return
+	}
+
+	switch msgType {
+	case msgSidecars:
+		r.receiveSidecars(k, payload, e.Src)
+	case msgRequest:
+		sidecars, found := r.get(k)
+		if !found {
+			return
+		}
+		e.Src.TrySend(p2p.Envelope{
+			ChannelID: ChannelID,
+			Message: &gogotypes.BytesValue{
+				Value: encodeMessage(msgSidecars, k, sidecars),
+			},
+		})
+	default:
+		r.stopPeer(e.Src, ErrInvalidMessage)
+	}
+}
+
+// RemovePeer implements p2p.Reactor.
+func (r *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pending, peer.ID())
+}
+
+// receiveSidecars handles sidecars sent by a peer. They are verified right
+// away if the block is being fetched, and kept as pending otherwise.
+func (r *Reactor) receiveSidecars(k key, sidecars []byte, from p2p.Peer) {
+	r.mu.Lock()
+	_, found := r.cache[k]
+	var verify func([]byte) error
+	if len(r.waiters[k]) > 0 {
+		verify = r.waiters[k][0].verify
+	}
+	r.mu.Unlock()
+
+	switch {
+	case found:
+		// Already known, e.g. both gossiped and requested.
+	case verify != nil:
+		r.accept(k, sidecars, from, verify)
+	default:
+		r.addPending(pending{key: k, sidecars: sidecars, from: from})
+	}
+}
+
+// accept verifies sidecars sent by a peer, caching them and notifying the
+// waiters if they are valid, and dropping them and disconnecting the peer
+// otherwise.
+func (r *Reactor) accept(
+	k key, sidecars []byte, from p2p.Peer, verify func([]byte) error,
+) bool {
+	if verify != nil {
+		if err := verify(sidecars); err != nil {
+			r.logger.Warn(
+				"Received invalid blob sidecars",
+				"slot", k.slot, "root", k.root, "error", err,
+			)
+			r.stopPeer(from, errors.Wrap(ErrInvalidSidecars, err.Error()))
+			return false
+		}
+	}
+	r.put(k, sidecars)
+	return true
+}
+
+// get returns the verified sidecars of a block from the cache or the source.
+func (r *Reactor) get(k key) ([]byte, bool) {
+	r.mu.Lock()
+	sidecars, found := r.cache[k]
+	r.mu.Unlock()
+	if found {
+		return sidecars, true
+	}
+	if r.source == nil {
+		return nil, false
+	}
+
+	sidecars, root, err := r.source(k.slot)
+	if err != nil {
+		r.logger.Error(
+			"Failed to read sidecars for peer", "slot", k.slot, "error", err,
+		)
+		return nil, false
+	}
+	// The stored sidecars may belong to another block of the same slot.
+	return sidecars, len(sidecars) > 0 && root == k.root
+}
+
+// put caches the verified sidecars of a block and notifies its waiters.
+func (r *Reactor) put(k key, sidecars []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, found := r.cache[k]; !found {
+		if len(r.order) == cacheSize {
+			delete(r.cache, r.order[0])
+			r.order = r.order[1:]
+		}
+		r.order = append(r.order, k)
+	}
+	r.cache[k] = sidecars
+
+	for _, w := range r.waiters[k] {
+		select {
+		case w.ch <- sidecars:
+		default:
+		}
+	}
+}
+
+// addPending keeps sidecars sent by a peer ahead of their block, evicting
+// the oldest ones of the same peer beyond pendingPerPeer.
+func (r *Reactor) addPending(p pending) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := peerID(p.from)
+	list := slices.DeleteFunc(r.pending[id], func(o pending) bool {
+		return o.key == p.key
+	})
+	list = append(list, p)
+	if len(list) > pendingPerPeer {
+		list = list[len(list)-pendingPerPeer:]
+	}
+	r.pending[id] = list
+}
+
+// takePending removes and returns the pending sidecars of a block. It must
+// be called with mu held.
+func (r *Reactor) takePending(k key) []pending {
+	var taken []pending
+	for id, list := range r.pending {
+		r.pending[id] = slices.DeleteFunc(list, func(p pending) bool {
+			if p.key != k {
+				return false
+			}
+			taken = append(taken, p)
+			return true
+		})
+		if len(r.pending[id]) == 0 {
+			delete(r.pending, id)
+		}
+	}
+	return taken
+}
+
+// removeWaiter stops notifying w of the sidecars of a block.
+func (r *Reactor) removeWaiter(k key, w *waiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.waiters[k] = slices.DeleteFunc(r.waiters[k], func(o *waiter) bool {
+		return o == w
+	})
+	if len(r.waiters[k]) == 0 {
+		delete(r.waiters, k)
+	}
+}
+
+// request asks a single peer for the sidecars of a block, a different one
+// for each attempt. Fetch starts from the slot so that the fetches of
+// successive blocks are spread over peers.
+func (r *Reactor) request(k key, attempt uint64) {
+	if r.Switch == nil {
+		return
+	}
+	peers := r.Switch.Peers().Copy()
+	if len(peers) == 0 {
+		return
+	}
+	slices.SortFunc(peers, func(a, b p2p.Peer) int {
+		return strings.Compare(string(a.ID()), string(b.ID()))
+	})
+	peers[attempt%uint64(len(peers))].TrySend(p2p.Envelope{
+		ChannelID: ChannelID,
+		Message: &gogotypes.BytesValue{
+			Value: encodeMessage(msgRequest, k, nil),
+		},
+	})
+}
+
+// broadcast sends msg to every peer without blocking.
+func (r *Reactor) broadcast(msg []byte) {
+	if r.Switch == nil {
+		return
+	}
+	r.Switch.TryBroadcast(p2p.Envelope{
+		ChannelID: ChannelID,
+		Message:   &gogotypes.BytesValue{Value: msg},
+	})
+}
+
+// stopPeer disconnects a peer that sent an invalid message.
+func (r *Reactor) stopPeer(peer p2p.Peer, reason error) {
+	if r.Switch == nil || peer == nil {
+		return
+	}
+	r.Switch.StopPeerForError(peer, reason)
+}
+
+// peerID returns the ID of peer, empty if unknown.
+func peerID(peer p2p.Peer) p2p.ID {
+	if peer == nil {
+		return ""
+	}
+	return peer.ID()
+}
+
+// encodeMessage encodes a message of the given type.
+func encodeMessage(msgType byte, k key, payload []byte) []byte {
+	bz := make([]byte, headerSize, headerSize+len(payload))
+	bz[0] = msgType
+	binary.BigEndian.PutUint64(bz[1:9], k.slot)
+	copy(bz[9:headerSize], k.root[:])
+	return append(bz, payload...)
+}
+
+// decodeMessage decodes a message encoded with encodeMessage.
+func decodeMessage(bz []byte) (byte, key, []byte, error) {
+	if len(bz) < headerSize {
+		return 0, key{}, nil, ErrInvalidMessage
+	}
+	var k key
+	k.slot = binary.BigEndian.Uint64(bz[1:9])
+	copy(k.root[:], bz[9:headerSize])
+	return bz[0], k, bz[headerSize:], nil
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip_test
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/da/gossip"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPeer is a peer recording the envelopes sent to it.
+type recordingPeer struct {
+	*mock.Peer
+	mu   sync.Mutex
+	sent []p2p.Envelope
+}
+
+func (p *recordingPeer) TrySend(e p2p.Envelope) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sent = append(p.sent, e)
+	return true
+}
+
+func (p *recordingPeer) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.sent)
+}
+
+func newReactor(timeout time.Duration, source gossip.Source) *gossip.Reactor {
+	return gossip.NewReactor(
+		gossip.Config{FetchTimeout: timeout},
+		noop.NewLogger[any](),
+		source,
+	)
+}
+
+func message(
+	msgType byte, slot uint64, root common.Root, payload []byte,
+) p2p.Envelope {
+	bz := make([]byte, 41, 41+len(payload))
+	bz[0] = msgType
+	binary.BigEndian.PutUint64(bz[1:9], slot)
+	copy(bz[9:], root[:])
+	return p2p.Envelope{
+		ChannelID: gossip.ChannelID,
+		Message:   &gogotypes.BytesValue{Value: append(bz, payload...)},
+	}
+}
+
+// expect returns a verifier accepting only want.
+func expect(want string) func([]byte) error {
+	return func(sidecars []byte) error {
+		if string(sidecars) != want {
+			return errors.New("unexpected sidecars")
+		}
+		return nil
+	}
+}
+
+func TestReactor_PublishAndFetch(t *testing.T) {
+	r := newReactor(100*time.Millisecond, nil)
+	root := common.Root{1}
+	r.Publish(5, root, []byte("sidecars"))
+
+	bz, err := r.Fetch(context.Background(), 5, root, expect("sidecars"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("sidecars"), bz)
+
+	_, err = r.Fetch(
+		context.Background(), 5, common.Root{2}, expect("sidecars"),
+	)
+	require.ErrorIs(t, err, gossip.ErrSidecarsUnavailable)
+}
+
+func TestReactor_ReceiveWakesFetch(t *testing.T) {
+	r := newReactor(time.Second, nil)
+	root := common.Root{3}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		r.Receive(message(1, 7, root, []byte("gossiped")))
+	}()
+	bz, err := r.Fetch(context.Background(), 7, root, expect("gossiped"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("gossiped"), bz)
+}
+
+func TestReactor_InvalidSidecarsAreRejected(t *testing.T) {
+	r := newReactor(time.Second, nil)
+	root := common.Root{5}
+	peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+
+	// Sidecars received ahead of the block are not served before they are
+	// verified.
+	gossiped := message(1, 7, root, []byte("invalid"))
+	gossiped.Src = peer
+	r.Receive(gossiped)
+	req := message(2, 7, root, nil)
+	req.Src = peer
+	r.Receive(req)
+	require.Empty(t, peer.sent)
+
+	// Invalid sidecars are dropped and Fetch waits for valid ones.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		r.Receive(message(1, 7, root, []byte("forged")))
+		r.Receive(message(1, 7, root, []byte("valid")))
+	}()
+	bz, err := r.Fetch(context.Background(), 7, root, expect("valid"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("valid"), bz)
+
+	r.Receive(req)
+	require.Len(t, peer.sent, 1)
+	require.Equal(t,
+		message(1, 7, root, []byte("valid")).Message, peer.sent[0].Message,
+	)
+}
+
+func TestReactor_ServeRequest(t *testing.T) {
+	r := newReactor(time.Second, func(slot uint64) ([]byte, common.Root, error) {
+		if slot == 9 {
+			return []byte("stored"), common.Root{4}, nil
+		}
+		return nil, common.Root{}, nil
+	})
+	peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+
+	// Unknown sidecars are not answered.
+	r.Receive(p2p.Envelope{
+		Src: peer, ChannelID: gossip.ChannelID,
+		Message: message(2, 8, common.Root{}, nil).Message,
+	})
+	require.Empty(t, peer.sent)
+
+	// Sidecars are served from the source once no longer cached.
+	req := message(2, 9, common.Root{4}, nil)
+	req.Src = peer
+	r.Receive(req)
+	require.Len(t, peer.sent, 1)
+	require.Equal(t,
+		message(1, 9, common.Root{4}, []byte("stored")).Message,
+		peer.sent[0].Message,
+	)
+
+	// Sidecars of another block of the same slot are not served.
+	req = message(2, 9, common.Root{5}, nil)
+	req.Src = peer
+	r.Receive(req)
+	require.Len(t, peer.sent, 1)
+}
+
+func TestReactor_PeersCannotEvictPublished(t *testing.T) {
+	r := newReactor(50*time.Millisecond, nil)
+	r.Publish(1, common.Root{1}, []byte("published"))
+
+	// A peer gossiping many blocks only keeps its latest ones pending.
+	peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+	for slot := range uint64(100) {
+		gossiped := message(1, 100+slot, common.Root{1}, []byte("gossiped"))
+		gossiped.Src = peer
+		r.Receive(gossiped)
+	}
+
+	bz, err := r.Fetch(context.Background(), 1, common.Root{1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("published"), bz)
+	bz, err = r.Fetch(
+		context.Background(), 199, common.Root{1}, expect("gossiped"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []byte("gossiped"), bz)
+	_, err = r.Fetch(
+		context.Background(), 100, common.Root{1}, expect("gossiped"),
+	)
+	require.ErrorIs(t, err, gossip.ErrSidecarsUnavailable)
+}
+
+func TestReactor_FetchAsksOnePeerAtATime(t *testing.T) {
+	r := newReactor(700*time.Millisecond, nil)
+	sw := p2p.NewSwitch(config.DefaultP2PConfig(), nil)
+	r.SetSwitch(sw)
+	peers := make([]*recordingPeer, 3)
+	for i := range peers {
+		peers[i] = &recordingPeer{Peer: mock.NewPeer(nil)}
+		p2p.AddPeerToSwitchPeerSet(sw, peers[i])
+	}
+
+	_, err := r.Fetch(context.Background(), 7, common.Root{6}, nil)
+	require.ErrorIs(t, err, gossip.ErrSidecarsUnavailable)
+
+	// Each request goes to the next peer rather than to all of them.
+	var requests int
+	for i, peer := range peers {
+		require.LessOrEqual(t, peer.count(), 1, "peer %d", i)
+		requests += peer.count()
+	}
+	require.GreaterOrEqual(t, requests, 2)
+}
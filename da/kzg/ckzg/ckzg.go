@@ -21,6 +21,10 @@
 package ckzg
 
 import (
+	"github.com/berachain/beacon-kit/da/kzg/peerdas"
+	"github.com/berachain/beacon-kit/da/kzg/types"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
@@ -30,7 +34,11 @@ import (
 const Implementation = "ethereum/c-kzg-4844"
 
 // Verifier is a verifier that utilizies the CKZG library.
-type Verifier struct{}
+type Verifier struct {
+	// cells computes and verifies EIP-7594 cells, which c-kzg-4844 v1 does
+	// not support.
+	cells *peerdas.Lazy
+}
 
 // GetImplementation returns the implementation of the verifier.
 func (v Verifier) GetImplementation() string {
@@ -58,5 +66,44 @@ func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	if err := ckzg4844.LoadTrustedSetup(g1s, g2s); err != nil {
 		return nil, err
 	}
-	return &Verifier{}, nil
+	return &Verifier{cells: peerdas.NewLazy(ts)}, nil
+}
+
+// ComputeCellsAndKZGProofs returns the cells of the extended blob and their
+// KZG proofs, using the Go implementation over the loaded trusted setup, so
+// that it does not depend on cgo.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx.ComputeCellsAndKZGProofs(blob)
+}
+
+// RecoverCellsAndKZGProofs recovers all the cells of the extended blob and
+// their KZG proofs from at least half of the cells, using the Go
+// implementation over the loaded trusted setup.
+func (v Verifier) RecoverCellsAndKZGProofs(
+	cellIndices []uint64,
+	cells []eip7594.Cell,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx.RecoverCellsAndKZGProofs(cellIndices, cells)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells, using
+// the Go implementation over the loaded trusted setup.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return err
+	}
+	return ctx.VerifyCellKZGProofBatch(
+		args.Commitments, args.CellIndices, args.Cells, args.Proofs,
+	)
 }
@@ -30,6 +30,9 @@ import (
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
 )
 
+// Available reports whether the executable was built with c-kzg-4844.
+const Available = true
+
 // VerifyProof verifies the KZG proof that the polynomial represented by the
 // blob evaluated at the given point is the claimed value.
 func (v Verifier) VerifyBlobProof(
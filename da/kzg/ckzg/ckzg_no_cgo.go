@@ -27,6 +27,9 @@ import (
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 )
 
+// Available reports whether the executable was built with c-kzg-4844.
+const Available = false
+
 // VerifyBlobProof will error since cgo is not enabled.
 func (v Verifier) VerifyBlobProof(
 	*eip4844.Blob,
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ckzg_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/da/kzg/types"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCellKZGProofs checks that cells are handled the same with and without
+// cgo, since they do not go through c-kzg-4844.
+func TestCellKZGProofs(t *testing.T) {
+	blob, _, commitment := setupTestData(t, "test_data.json")
+	cells, proofs, err := globalVerifier.ComputeCellsAndKZGProofs(blob)
+	require.NoError(t, err)
+	require.Len(t, cells, eip7594.CellsPerExtBlob)
+
+	indices := []uint64{0, 64, 127}
+	args := &types.CellProofArgs{}
+	for _, index := range indices {
+		args.Commitments = append(args.Commitments, commitment)
+		args.CellIndices = append(args.CellIndices, index)
+		args.Cells = append(args.Cells, cells[index])
+		args.Proofs = append(args.Proofs, proofs[index])
+	}
+	require.NoError(t, globalVerifier.VerifyCellKZGProofBatch(args))
+
+	// Half of the cells are enough to recover all of them.
+	var (
+		halfIndices []uint64
+		halfCells   []eip7594.Cell
+	)
+	for i := uint64(1); i < eip7594.CellsPerExtBlob; i += 2 {
+		halfIndices = append(halfIndices, i)
+		halfCells = append(halfCells, cells[i])
+	}
+	recovered, recoveredProofs, err := globalVerifier.RecoverCellsAndKZGProofs(
+		halfIndices, halfCells,
+	)
+	require.NoError(t, err)
+	require.Equal(t, cells, recovered)
+	require.Equal(t, proofs, recoveredProofs)
+}
@@ -26,6 +26,8 @@ const (
 	// defaultImplementation is the default KZG implementation to use.
 	// Options are `crate-crypto/go-kzg-4844` or `ethereum/c-kzg-4844`.
 	defaultImplementation = "crate-crypto/go-kzg-4844"
+	// defaultSelfTest is whether the verifier is self-tested by default.
+	defaultSelfTest = true
 )
 
 type Config struct {
@@ -33,6 +35,12 @@ type Config struct {
 	TrustedSetupPath string `mapstructure:"trusted-setup-path"`
 	// Implementation is the KZG implementation to use.
 	Implementation string `mapstructure:"implementation"`
+	// SelfTest enables the verification of a known blob, commitment and
+	// proof at startup.
+	SelfTest bool `mapstructure:"self-test"`
+	// SelfTestVectorPath is the path to the blob, commitment and proof
+	// verified at startup. The built-in vector is used if empty.
+	SelfTestVectorPath string `mapstructure:"self-test-vector-path"`
 }
 
 // DefaultConfig returns the default configuration.
@@ -40,5 +48,6 @@ func DefaultConfig() Config {
 	return Config{
 		TrustedSetupPath: defaultTrustedSetupPath,
 		Implementation:   defaultImplementation,
+		SelfTest:         defaultSelfTest,
 	}
 }
@@ -39,4 +39,6 @@ func TestDefaultConfig(t *testing.T) {
 		"crate-crypto/go-kzg-4844",
 		cfg.Implementation,
 	)
+	require.True(t, cfg.SelfTest)
+	require.Empty(t, cfg.SelfTestVectorPath)
 }
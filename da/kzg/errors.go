@@ -28,4 +28,8 @@ var (
 	ErrUnsupportedKzgImplementation = errors.New(
 		"unsupported KZG implementation",
 	)
+
+	// ErrSelfTestFailed is returned when a KZG implementation does not
+	// produce the expected result on the self-test vector.
+	ErrSelfTestFailed = errors.New("KZG self-test failed")
 )
@@ -23,8 +23,10 @@ package gokzg
 import (
 	"unsafe"
 
+	"github.com/berachain/beacon-kit/da/kzg/peerdas"
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 )
 
@@ -33,6 +35,9 @@ const Implementation = "crate-crypto/go-kzg-4844"
 // Verifier is a KZG verifier that uses the Go implementation of KZG.
 type Verifier struct {
 	*gokzg4844.Context
+	// cells computes and verifies EIP-7594 cells, which go-kzg-4844 does
+	// not support.
+	cells *peerdas.Lazy
 }
 
 // NewVerifier creates a new GoKZGVerifier.
@@ -41,7 +46,7 @@ func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Verifier{ctx}, nil
+	return &Verifier{Context: ctx, cells: peerdas.NewLazy(ts)}, nil
 }
 
 // GetImplementation returns the implementation of the verifier.
@@ -83,3 +88,39 @@ func (v Verifier) VerifyBlobProofBatch(
 			*(*[]gokzg4844.KZGProof)(unsafe.Pointer(&args.Proofs)),
 		)
 }
+
+// ComputeCellsAndKZGProofs returns the cells of the extended blob and their
+// KZG proofs.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx.ComputeCellsAndKZGProofs(blob)
+}
+
+// RecoverCellsAndKZGProofs recovers all the cells of the extended blob and
+// their KZG proofs from at least half of the cells.
+func (v Verifier) RecoverCellsAndKZGProofs(
+	cellIndices []uint64,
+	cells []eip7594.Cell,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx.RecoverCellsAndKZGProofs(cellIndices, cells)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	ctx, err := v.cells.Get()
+	if err != nil {
+		return err
+	}
+	return ctx.VerifyCellKZGProofBatch(
+		args.Commitments, args.CellIndices, args.Cells, args.Proofs,
+	)
+}
@@ -23,6 +23,7 @@ package noop
 import (
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 )
 
 // Verifier is a no-op KZG proof verifier.
@@ -53,3 +54,27 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return nil
 }
+
+// ComputeCellsAndKZGProofs returns empty cells and proofs.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	*eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	return make([]eip7594.Cell, eip7594.CellsPerExtBlob),
+		make([]eip4844.KZGProof, eip7594.CellsPerExtBlob), nil
+}
+
+// RecoverCellsAndKZGProofs returns empty cells and proofs.
+func (v Verifier) RecoverCellsAndKZGProofs(
+	[]uint64,
+	[]eip7594.Cell,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	return make([]eip7594.Cell, eip7594.CellsPerExtBlob),
+		make([]eip4844.KZGProof, eip7594.CellsPerExtBlob), nil
+}
+
+// VerifyCellKZGProofBatch is a no-op.
+func (v Verifier) VerifyCellKZGProofBatch(
+	*types.CellProofArgs,
+) error {
+	return nil
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrInvalidCellProof is returned when cell KZG proofs do not verify.
+	ErrInvalidCellProof = errors.New("invalid cell KZG proof")
+
+	// ErrInvalidCellIndex is returned when a cell index is out of range.
+	ErrInvalidCellIndex = errors.New("invalid cell index")
+
+	// ErrBatchLength is returned when the arguments of a batch verification
+	// do not have the same length.
+	ErrBatchLength = errors.New(
+		"the number of commitments, cell indices, cells and proofs " +
+			"must be the same",
+	)
+
+	// ErrRecoveryLength is returned when the number of cells to recover from
+	// differs from the number of cell indices.
+	ErrRecoveryLength = errors.New(
+		"the number of cell indices and cells must be the same",
+	)
+
+	// ErrNotEnoughCells is returned when recovering from fewer than half of
+	// the cells of an extended blob, or more than all of them.
+	ErrNotEnoughCells = errors.New(
+		"recovery needs between half and all of the cells",
+	)
+
+	// ErrNonCanonicalScalar is returned when 32 bytes of a blob or cell do
+	// not encode a field element.
+	ErrNonCanonicalScalar = errors.New("scalar is not canonical")
+
+	// ErrInvalidTrustedSetup is returned when the trusted setup does not
+	// hold enough points.
+	ErrInvalidTrustedSetup = errors.New("invalid trusted setup")
+)
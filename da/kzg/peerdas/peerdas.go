@@ -0,0 +1,533 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package peerdas implements the cell computation and cell proof
+// verification of EIP-7594 on top of the EIP-4844 trusted setup:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/polynomial-commitments-sampling.md
+package peerdas
+
+import (
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// scalarsPerBlob is the number of field elements in a blob.
+	scalarsPerBlob = len(eip4844.Blob{}) / fr.Bytes
+	// scalarsPerExtBlob is the number of field elements in an extended blob.
+	scalarsPerExtBlob = 2 * scalarsPerBlob
+	// cellIndexBits is the number of bits of a cell index.
+	cellIndexBits = 7
+)
+
+// Context computes and verifies cells and their KZG proofs.
+type Context struct {
+	// lagrange is the G1 setup in Lagrange form, in bit-reversed order to
+	// match the order of the evaluations in blobs.
+	lagrange []bls12381.G1Affine
+	// g2Gen is the generator of G2.
+	g2Gen bls12381.G2Affine
+	// g2TauCell is tau to the power of FieldElementsPerCell in G2.
+	g2TauCell bls12381.G2Affine
+	// blobDomain is the domain blobs are evaluated over.
+	blobDomain *fft.Domain
+	// extDomain is the domain extended blobs are evaluated over.
+	extDomain *fft.Domain
+	// cellDomain is the subgroup whose cosets cells are evaluated over.
+	cellDomain *fft.Domain
+}
+
+// NewContext creates a new Context from the EIP-4844 trusted setup.
+func NewContext(ts *gokzg4844.JSONTrustedSetup) (*Context, error) {
+	if len(ts.SetupG2) <= eip7594.FieldElementsPerCell {
+		return nil, errors.Wrapf(
+			ErrInvalidTrustedSetup, "%d G2 points, need %d",
+			len(ts.SetupG2), eip7594.FieldElementsPerCell+1,
+		)
+	}
+
+	c := &Context{
+		lagrange:   make([]bls12381.G1Affine, len(ts.SetupG1Lagrange)),
+		blobDomain: fft.NewDomain(uint64(scalarsPerBlob)),
+		extDomain:  fft.NewDomain(uint64(scalarsPerExtBlob)),
+		cellDomain: fft.NewDomain(eip7594.FieldElementsPerCell),
+	}
+	if err := setG2(&c.g2Gen, ts.SetupG2[0]); err != nil {
+		return nil, err
+	}
+	if err := setG2(
+		&c.g2TauCell, ts.SetupG2[eip7594.FieldElementsPerCell],
+	); err != nil {
+		return nil, err
+	}
+
+	var g errgroup.Group
+	for i, point := range ts.SetupG1Lagrange {
+		g.Go(func() error {
+			bz, err := hex.ToBytes(point)
+			if err != nil {
+				return err
+			}
+			_, err = c.lagrange[i].SetBytes(bz)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, errors.Wrap(ErrInvalidTrustedSetup, err.Error())
+	}
+	// The trusted setup lists the Lagrange points in natural order.
+	bitReverse(c.lagrange)
+	return c, nil
+}
+
+// Lazy creates a Context on first use, so that decoding the trusted setup
+// does not slow down nodes that never handle cells.
+type Lazy struct {
+	ts   *gokzg4844.JSONTrustedSetup
+	once sync.Once
+	ctx  *Context
+	err  error
+}
+
+// NewLazy creates a new Lazy for the trusted setup.
+func NewLazy(ts *gokzg4844.JSONTrustedSetup) *Lazy {
+	return &Lazy{ts: ts}
+}
+
+// Get returns the Context, creating it on the first call.
+func (l *Lazy) Get() (*Context, error) {
+	l.once.Do(func() {
+		l.ctx, l.err = NewContext(l.ts)
+	})
+	return l.ctx, l.err
+}
+
+// ComputeCells returns the cells of the extended blob.
+func (c *Context) ComputeCells(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, error) {
+	coeffs, err := c.blobToCoefficients(blob)
+	if err != nil {
+		return nil, err
+	}
+	return c.coefficientsToCells(coeffs), nil
+}
+
+// ComputeCellsAndKZGProofs returns the cells of the extended blob and the
+// KZG proof of each cell.
+func (c *Context) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	coeffs, err := c.blobToCoefficients(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.cellsAndProofs(coeffs)
+}
+
+// RecoverCellsAndKZGProofs returns all the cells of the extended blob and the
+// KZG proof of each cell, given at least half of the cells and their indices.
+func (c *Context) RecoverCellsAndKZGProofs(
+	cellIndices []uint64,
+	cells []eip7594.Cell,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	if len(cellIndices) != len(cells) {
+		return nil, nil, ErrRecoveryLength
+	}
+	if len(cells) < eip7594.CellsPerExtBlob/2 ||
+		len(cells) > eip7594.CellsPerExtBlob {
+		return nil, nil, errors.Wrapf(
+			ErrNotEnoughCells, "got %d cells", len(cells),
+		)
+	}
+	var present [eip7594.CellsPerExtBlob]bool
+	for _, index := range cellIndices {
+		if index >= eip7594.CellsPerExtBlob || present[index] {
+			return nil, nil, errors.Wrapf(ErrInvalidCellIndex, "%d", index)
+		}
+		present[index] = true
+	}
+
+	coeffs, err := c.recoverCoefficients(cellIndices, cells, &present)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.cellsAndProofs(coeffs)
+}
+
+// cellsAndProofs returns the cells of the extended blob of the polynomial
+// with the given coefficients and the KZG proof of each cell.
+func (c *Context) cellsAndProofs(
+	coeffs []fr.Element,
+) ([]eip7594.Cell, []eip4844.KZGProof, error) {
+	proofs := make([]eip4844.KZGProof, eip7594.CellsPerExtBlob)
+	var g errgroup.Group
+	for i := range eip7594.CellsPerExtBlob {
+		g.Go(func() error {
+			proof, pErr := c.computeCellProof(coeffs, uint64(i))
+			if pErr != nil {
+				return pErr
+			}
+			proofs[i] = proof.Bytes()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return c.coefficientsToCells(coeffs), proofs, nil
+}
+
+// VerifyCellKZGProofBatch verifies that each cell is the cell with the
+// given index of the blob committed to, using a single pairing check over a
+// random linear combination of the proofs.
+func (c *Context) VerifyCellKZGProofBatch(
+	commitments []eip4844.KZGCommitment,
+	cellIndices []uint64,
+	cells []eip7594.Cell,
+	proofs []eip4844.KZGProof,
+) error {
+	n := len(cells)
+	if len(commitments) != n || len(cellIndices) != n || len(proofs) != n {
+		return ErrBatchLength
+	}
+	if n == 0 {
+		return nil
+	}
+
+	var (
+		commitmentPoints = make([]bls12381.G1Affine, n)
+		proofPoints      = make([]bls12381.G1Affine, n)
+		powers           = make([]fr.Element, n)
+		shiftedPowers    = make([]fr.Element, n)
+		interpolation    = make([]fr.Element, scalarsPerBlob)
+		r                fr.Element
+	)
+	if _, err := r.SetRandom(); err != nil {
+		return err
+	}
+	powers[0].SetOne()
+	for k := 1; k < n; k++ {
+		powers[k].Mul(&powers[k-1], &r)
+	}
+
+	for k := range n {
+		if cellIndices[k] >= eip7594.CellsPerExtBlob {
+			return errors.Wrapf(ErrInvalidCellIndex, "%d", cellIndices[k])
+		}
+		if _, err := commitmentPoints[k].SetBytes(commitments[k][:]); err != nil {
+			return err
+		}
+		if _, err := proofPoints[k].SetBytes(proofs[k][:]); err != nil {
+			return err
+		}
+
+		// The proof is the commitment to (p(X) - I(X)) / (X^n - h^n) where I
+		// interpolates the cell over the coset h<ζ>, so the pairing check
+		// becomes e(π, τ^n) = e(C - I(τ) + h^n π, 1).
+		shift := c.cellShift(cellIndices[k])
+		var shiftN fr.Element
+		shiftN.Exp(shift, big.NewInt(eip7594.FieldElementsPerCell))
+		shiftedPowers[k].Mul(&powers[k], &shiftN)
+
+		coeffs, err := c.interpolateCell(&cells[k], shift)
+		if err != nil {
+			return err
+		}
+		for m := range coeffs {
+			coeffs[m].Mul(&coeffs[m], &powers[k])
+			interpolation[m].Add(&interpolation[m], &coeffs[m])
+		}
+	}
+
+	var proofSum, commitmentSum, shiftedProofSum bls12381.G1Jac
+	if _, err := proofSum.MultiExp(
+		proofPoints, powers, multiExpConfig,
+	); err != nil {
+		return err
+	}
+	if _, err := commitmentSum.MultiExp(
+		commitmentPoints, powers, multiExpConfig,
+	); err != nil {
+		return err
+	}
+	if _, err := shiftedProofSum.MultiExp(
+		proofPoints, shiftedPowers, multiExpConfig,
+	); err != nil {
+		return err
+	}
+	interpolationCommitment, err := c.commit(interpolation)
+	if err != nil {
+		return err
+	}
+
+	var rhs bls12381.G1Jac
+	rhs.Set(&commitmentSum).
+		SubAssign(interpolationCommitment).
+		AddAssign(&shiftedProofSum)
+	var lhsAffine, rhsAffine bls12381.G1Affine
+	lhsAffine.FromJacobian(&proofSum)
+	rhsAffine.FromJacobian(&rhs)
+	rhsAffine.Neg(&rhsAffine)
+
+	ok, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{lhsAffine, rhsAffine},
+		[]bls12381.G2Affine{c.g2TauCell, c.g2Gen},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidCellProof
+	}
+	return nil
+}
+
+// multiExpConfig is the configuration of the multi-scalar multiplications.
+//
+//nolint:gochecknoglobals // read-only.
+var multiExpConfig = ecc.MultiExpConfig{}
+
+// blobToCoefficients returns the coefficients of the polynomial whose
+// evaluations over the blob domain, in bit-reversed order, are the blob.
+func (c *Context) blobToCoefficients(
+	blob *eip4844.Blob,
+) ([]fr.Element, error) {
+	coeffs := make([]fr.Element, scalarsPerBlob)
+	for i := range coeffs {
+		if err := coeffs[i].SetBytesCanonical(
+			blob[i*fr.Bytes : (i+1)*fr.Bytes],
+		); err != nil {
+			return nil, errors.Wrapf(ErrNonCanonicalScalar, "index %d", i)
+		}
+	}
+	fft.BitReverse(coeffs)
+	c.blobDomain.FFTInverse(coeffs, fft.DIF)
+	fft.BitReverse(coeffs)
+	return coeffs, nil
+}
+
+// recoverCoefficients returns the coefficients of the polynomial whose
+// evaluations over the extended domain include the given cells. With E the
+// extended blob with zeros in place of the missing cells and Z the
+// polynomial vanishing over the missing cells, E*Z and P*Z agree over the
+// extended domain, so that P is recovered by dividing P*Z by Z over a coset
+// of the domain, where Z does not vanish.
+func (c *Context) recoverCoefficients(
+	cellIndices []uint64,
+	cells []eip7594.Cell,
+	present *[eip7594.CellsPerExtBlob]bool,
+) ([]fr.Element, error) {
+	// The evaluations are in bit-reversed order, as in cells.
+	evals := make([]fr.Element, scalarsPerExtBlob)
+	for k, index := range cellIndices {
+		cellEvals := evals[index*eip7594.FieldElementsPerCell:]
+		for j := range eip7594.FieldElementsPerCell {
+			if err := cellEvals[j].SetBytesCanonical(
+				cells[k][j*fr.Bytes : (j+1)*fr.Bytes],
+			); err != nil {
+				return nil, errors.Wrapf(
+					ErrNonCanonicalScalar, "cell %d, index %d", index, j,
+				)
+			}
+		}
+	}
+
+	// The cell with shift h is the set of roots of X^n - h^n, so that
+	// Z(X) = S(X^n) where S vanishes at the h^n of the missing cells.
+	short := []fr.Element{fr.One()}
+	for index, found := range present {
+		if found {
+			continue
+		}
+		var root fr.Element
+		shift := c.cellShift(uint64(index))
+		root.Exp(shift, big.NewInt(eip7594.FieldElementsPerCell))
+		short = append(short, fr.Element{})
+		for m := len(short) - 1; m > 0; m-- {
+			var t fr.Element
+			t.Mul(&root, &short[m])
+			short[m].Sub(&short[m-1], &t)
+		}
+		short[0].Mul(&short[0], &root).Neg(&short[0])
+	}
+	zero := make([]fr.Element, scalarsPerExtBlob)
+	for m := range short {
+		zero[m*eip7594.FieldElementsPerCell] = short[m]
+	}
+
+	// E*Z in evaluation form, then in coefficient form.
+	zeroEvals := make([]fr.Element, scalarsPerExtBlob)
+	copy(zeroEvals, zero)
+	c.extDomain.FFT(zeroEvals, fft.DIF)
+	for i := range evals {
+		evals[i].Mul(&evals[i], &zeroEvals[i])
+	}
+	c.extDomain.FFTInverse(evals, fft.DIT)
+
+	// (P*Z) / Z over a coset of the extended domain.
+	c.extDomain.FFT(evals, fft.DIF, fft.OnCoset())
+	c.extDomain.FFT(zero, fft.DIF, fft.OnCoset())
+	zero = fr.BatchInvert(zero)
+	for i := range evals {
+		evals[i].Mul(&evals[i], &zero[i])
+	}
+	c.extDomain.FFTInverse(evals, fft.DIT, fft.OnCoset())
+	return evals[:scalarsPerBlob], nil
+}
+
+// coefficientsToCells evaluates the polynomial over the extended domain in
+// bit-reversed order and splits the evaluations into cells.
+func (c *Context) coefficientsToCells(coeffs []fr.Element) []eip7594.Cell {
+	evals := make([]fr.Element, scalarsPerExtBlob)
+	copy(evals, coeffs)
+	c.extDomain.FFT(evals, fft.DIF)
+
+	cells := make([]eip7594.Cell, eip7594.CellsPerExtBlob)
+	for i, eval := range evals {
+		bz := eval.Bytes()
+		cell := i / eip7594.FieldElementsPerCell
+		offset := (i % eip7594.FieldElementsPerCell) * fr.Bytes
+		copy(cells[cell][offset:], bz[:])
+	}
+	return cells
+}
+
+// computeCellProof returns the KZG proof of the cell with the given index,
+// the commitment to the quotient of the polynomial by the vanishing
+// polynomial X^n - h^n of the coset of the cell.
+func (c *Context) computeCellProof(
+	coeffs []fr.Element, index uint64,
+) (*bls12381.G1Affine, error) {
+	shift := c.cellShift(index)
+	var shiftN fr.Element
+	shiftN.Exp(shift, big.NewInt(eip7594.FieldElementsPerCell))
+
+	quotient := make([]fr.Element, scalarsPerBlob)
+	for k := len(coeffs) - 1; k >= eip7594.FieldElementsPerCell; k-- {
+		q := &quotient[k-eip7594.FieldElementsPerCell]
+		q.Set(&coeffs[k])
+		if k < len(coeffs)-eip7594.FieldElementsPerCell {
+			var t fr.Element
+			t.Mul(&shiftN, &quotient[k])
+			q.Add(q, &t)
+		}
+	}
+
+	commitment, err := c.commit(quotient)
+	if err != nil {
+		return nil, err
+	}
+	var proof bls12381.G1Affine
+	proof.FromJacobian(commitment)
+	return &proof, nil
+}
+
+// interpolateCell returns the coefficients of the polynomial of degree
+// below FieldElementsPerCell that takes the values of the cell over the
+// coset shift<ζ>.
+func (c *Context) interpolateCell(
+	cell *eip7594.Cell, shift fr.Element,
+) ([]fr.Element, error) {
+	coeffs := make([]fr.Element, eip7594.FieldElementsPerCell)
+	for j := range coeffs {
+		if err := coeffs[j].SetBytesCanonical(
+			cell[j*fr.Bytes : (j+1)*fr.Bytes],
+		); err != nil {
+			return nil, errors.Wrapf(ErrNonCanonicalScalar, "index %d", j)
+		}
+	}
+	// The cell holds the evaluations at shift*ζ^j in bit-reversed order,
+	// the inverse FFT yields the coefficients of I(shift*Y).
+	fft.BitReverse(coeffs)
+	c.cellDomain.FFTInverse(coeffs, fft.DIF)
+	fft.BitReverse(coeffs)
+
+	var shiftInv, scale fr.Element
+	shiftInv.Inverse(&shift)
+	scale.SetOne()
+	for m := range coeffs {
+		coeffs[m].Mul(&coeffs[m], &scale)
+		scale.Mul(&scale, &shiftInv)
+	}
+	return coeffs, nil
+}
+
+// commit returns the KZG commitment to the polynomial with the given
+// coefficients, of degree below the number of field elements in a blob.
+func (c *Context) commit(coeffs []fr.Element) (*bls12381.G1Jac, error) {
+	evals := make([]fr.Element, scalarsPerBlob)
+	copy(evals, coeffs)
+	c.blobDomain.FFT(evals, fft.DIF)
+
+	var commitment bls12381.G1Jac
+	if _, err := commitment.MultiExp(
+		c.lagrange, evals, multiExpConfig,
+	); err != nil {
+		return nil, err
+	}
+	return &commitment, nil
+}
+
+// cellShift returns the shift of the coset the cell with the given index is
+// evaluated over, the root of unity of the extended domain at the
+// bit-reversed index.
+func (c *Context) cellShift(index uint64) fr.Element {
+	exponent := bits.Reverse64(index) >> (64 - cellIndexBits)
+	var shift fr.Element
+	shift.Exp(c.extDomain.Generator, new(big.Int).SetUint64(exponent))
+	return shift
+}
+
+// bitReverse applies the bit-reversal permutation to points, whose length
+// must be a power of two.
+func bitReverse(points []bls12381.G1Affine) {
+	shift := 64 - bits.TrailingZeros64(uint64(len(points)))
+	for i := range points {
+		j := int(bits.Reverse64(uint64(i)) >> shift)
+		if i < j {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+}
+
+// setG2 decodes a compressed hex G2 point.
+func setG2(point *bls12381.G2Affine, hexPoint string) error {
+	bz, err := hex.ToBytes(hexPoint)
+	if err != nil {
+		return errors.Wrap(ErrInvalidTrustedSetup, err.Error())
+	}
+	if _, err = point.SetBytes(bz); err != nil {
+		return errors.Wrap(ErrInvalidTrustedSetup, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas_test
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/da/kzg/peerdas"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+var baseDir = "../../../testing/files/"
+
+func TestComputeCells(t *testing.T) {
+	ctx := setupContext(t)
+	blob, _ := setupTestData(t)
+
+	cells, err := ctx.ComputeCells(blob)
+	require.NoError(t, err)
+	require.Len(t, cells, eip7594.CellsPerExtBlob)
+
+	// The first half of the extended blob is the blob itself.
+	for i := range eip7594.CellsPerExtBlob / 2 {
+		require.Equal(t,
+			blob[i*eip7594.BytesPerCell:(i+1)*eip7594.BytesPerCell],
+			cells[i][:],
+		)
+	}
+
+	// Blobs must hold canonical field elements.
+	invalid := *blob
+	copy(invalid[:32], make([]byte, 32))
+	invalid[0] = 0xff
+	_, err = ctx.ComputeCells(&invalid)
+	require.ErrorIs(t, err, peerdas.ErrNonCanonicalScalar)
+}
+
+func TestVerifyCellKZGProofBatch(t *testing.T) {
+	ctx := setupContext(t)
+	blob, commitment := setupTestData(t)
+
+	cells, proofs, err := ctx.ComputeCellsAndKZGProofs(blob)
+	require.NoError(t, err)
+	require.Len(t, proofs, eip7594.CellsPerExtBlob)
+
+	indices := []uint64{0, 1, 63, 64, 100, 127}
+	commitments := make([]eip4844.KZGCommitment, len(indices))
+	batchCells := make([]eip7594.Cell, len(indices))
+	batchProofs := make([]eip4844.KZGProof, len(indices))
+	for i, index := range indices {
+		commitments[i] = commitment
+		batchCells[i] = cells[index]
+		batchProofs[i] = proofs[index]
+	}
+	require.NoError(t, ctx.VerifyCellKZGProofBatch(
+		commitments, indices, batchCells, batchProofs,
+	))
+
+	// A cell verified against the wrong index is rejected.
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(
+		commitments[:1], []uint64{1}, batchCells[:1], batchProofs[:1],
+	), peerdas.ErrInvalidCellProof)
+
+	// A tampered cell is rejected.
+	tampered := batchCells[2]
+	tampered[31]++
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(
+		commitments[:1], indices[2:3],
+		[]eip7594.Cell{tampered}, batchProofs[2:3],
+	), peerdas.ErrInvalidCellProof)
+
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(
+		commitments[:1], []uint64{eip7594.CellsPerExtBlob},
+		batchCells[:1], batchProofs[:1],
+	), peerdas.ErrInvalidCellIndex)
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(
+		commitments, indices[:1], batchCells, batchProofs,
+	), peerdas.ErrBatchLength)
+}
+
+func TestComputeCellsAndKZGProofsVectors(t *testing.T) {
+	ctx := setupContext(t)
+	for _, path := range referenceVectors(t, "compute_cells_and_kzg_proofs") {
+		t.Run(filepath.Base(filepath.Dir(path)), func(t *testing.T) {
+			var test struct {
+				Input struct {
+					Blob string `json:"blob"`
+				} `json:"input"`
+				Output *[2][]string `json:"output"`
+			}
+			readVector(t, path, &test)
+
+			var blob eip4844.Blob
+			err := blob.UnmarshalJSON([]byte(`"` + test.Input.Blob + `"`))
+			var (
+				cells  []eip7594.Cell
+				proofs []eip4844.KZGProof
+			)
+			if err == nil {
+				cells, proofs, err = ctx.ComputeCellsAndKZGProofs(&blob)
+			}
+			if test.Output == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			requireCellsAndProofs(t, *test.Output, cells, proofs)
+		})
+	}
+}
+
+func TestVerifyCellKZGProofBatchVectors(t *testing.T) {
+	ctx := setupContext(t)
+	for _, path := range referenceVectors(t, "verify_cell_kzg_proof_batch") {
+		t.Run(filepath.Base(filepath.Dir(path)), func(t *testing.T) {
+			var test struct {
+				Input struct {
+					Commitments []string `json:"commitments"`
+					CellIndices []uint64 `json:"cell_indices"`
+					Cells       []string `json:"cells"`
+					Proofs      []string `json:"proofs"`
+				} `json:"input"`
+				Output *bool `json:"output"`
+			}
+			readVector(t, path, &test)
+
+			commitments, err := decodeAll[eip4844.KZGCommitment](
+				test.Input.Commitments,
+			)
+			var cells []eip7594.Cell
+			if err == nil {
+				cells, err = decodeAll[eip7594.Cell](test.Input.Cells)
+			}
+			var proofs []eip4844.KZGProof
+			if err == nil {
+				proofs, err = decodeAll[eip4844.KZGProof](test.Input.Proofs)
+			}
+			if err == nil {
+				err = ctx.VerifyCellKZGProofBatch(
+					commitments, test.Input.CellIndices, cells, proofs,
+				)
+			}
+			switch {
+			case test.Output == nil:
+				require.Error(t, err)
+				require.NotErrorIs(t, err, peerdas.ErrInvalidCellProof)
+			case *test.Output:
+				require.NoError(t, err)
+			default:
+				require.ErrorIs(t, err, peerdas.ErrInvalidCellProof)
+			}
+		})
+	}
+}
+
+func TestRecoverCellsAndKZGProofsVectors(t *testing.T) {
+	ctx := setupContext(t)
+	for _, path := range referenceVectors(t, "recover_cells_and_kzg_proofs") {
+		t.Run(filepath.Base(filepath.Dir(path)), func(t *testing.T) {
+			var test struct {
+				Input struct {
+					CellIndices []uint64 `json:"cell_indices"`
+					Cells       []string `json:"cells"`
+				} `json:"input"`
+				Output *[2][]string `json:"output"`
+			}
+			readVector(t, path, &test)
+
+			partial, err := decodeAll[eip7594.Cell](test.Input.Cells)
+			var (
+				cells  []eip7594.Cell
+				proofs []eip4844.KZGProof
+			)
+			if err == nil {
+				cells, proofs, err = ctx.RecoverCellsAndKZGProofs(
+					test.Input.CellIndices, partial,
+				)
+			}
+			if test.Output == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			requireCellsAndProofs(t, *test.Output, cells, proofs)
+		})
+	}
+}
+
+// referenceVectors returns the paths of the consensus-spec test vectors of
+// the given function, as shipped with the c-kzg-4844 module.
+func referenceVectors(t *testing.T, function string) []string {
+	t.Helper()
+	dir, err := exec.Command(
+		"go", "list", "-m", "-f", "{{.Dir}}", "github.com/ethereum/c-kzg-4844",
+	).Output()
+	require.NoError(t, err)
+	paths, err := filepath.Glob(filepath.Join(
+		strings.TrimSpace(string(dir)), "tests", function, "kzg-mainnet",
+		"*", "data.yaml",
+	))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+	return paths
+}
+
+func readVector(t *testing.T, path string, test any) {
+	t.Helper()
+	file, err := afero.ReadFile(afero.NewOsFs(), path)
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(file, test))
+}
+
+func requireCellsAndProofs(
+	t *testing.T,
+	want [2][]string,
+	cells []eip7594.Cell,
+	proofs []eip4844.KZGProof,
+) {
+	t.Helper()
+	wantCells, err := decodeAll[eip7594.Cell](want[0])
+	require.NoError(t, err)
+	wantProofs, err := decodeAll[eip4844.KZGProof](want[1])
+	require.NoError(t, err)
+	require.Equal(t, wantCells, cells)
+	require.Equal(t, wantProofs, proofs)
+}
+
+// decodeAll decodes hex strings into values of a fixed size, failing on
+// strings of another size as the vectors expect.
+func decodeAll[T any, PT interface {
+	*T
+	UnmarshalJSON(input []byte) error
+}](hexes []string) ([]T, error) {
+	values := make([]T, len(hexes))
+	for i, h := range hexes {
+		if err := PT(&values[i]).UnmarshalJSON(
+			[]byte(`"` + h + `"`),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func setupContext(t *testing.T) *peerdas.Context {
+	t.Helper()
+	file, err := afero.ReadFile(
+		afero.NewOsFs(), filepath.Join(baseDir, "kzg-trusted-setup.json"),
+	)
+	require.NoError(t, err)
+	var ts gokzg4844.JSONTrustedSetup
+	require.NoError(t, json.Unmarshal(file, &ts))
+
+	ctx, err := peerdas.NewContext(&ts)
+	require.NoError(t, err)
+	return ctx
+}
+
+func setupTestData(t *testing.T) (*eip4844.Blob, eip4844.KZGCommitment) {
+	t.Helper()
+	file, err := afero.ReadFile(
+		afero.NewOsFs(), filepath.Join(baseDir, "test_data.json"),
+	)
+	require.NoError(t, err)
+	var test struct {
+		Input struct {
+			Blob       string `json:"blob"`
+			Commitment string `json:"commitment"`
+		} `json:"input"`
+	}
+	require.NoError(t, json.Unmarshal(file, &test))
+
+	var (
+		blob       eip4844.Blob
+		commitment eip4844.KZGCommitment
+	)
+	require.NoError(t, blob.UnmarshalJSON([]byte(`"`+test.Input.Blob+`"`)))
+	require.NoError(t, commitment.UnmarshalJSON(
+		[]byte(`"`+test.Input.Commitment+`"`),
+	))
+	return &blob, commitment
+}
@@ -26,6 +26,7 @@ import (
 	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 )
 
@@ -46,10 +47,26 @@ type BlobProofVerifier interface {
 	// For most implementations it is more efficient than VerifyBlobProof when
 	// verifying multiple proofs.
 	VerifyBlobProofBatch(*kzgtypes.BlobProofArgs) error
+	// ComputeCellsAndKZGProofs erasure codes the blob and returns the cells
+	// of the extended blob along with their KZG proofs, as per EIP-7594.
+	ComputeCellsAndKZGProofs(
+		blob *eip4844.Blob,
+	) ([]eip7594.Cell, []eip4844.KZGProof, error)
+	// RecoverCellsAndKZGProofs recovers all the cells of the extended blob,
+	// along with their KZG proofs, from at least half of them.
+	RecoverCellsAndKZGProofs(
+		cellIndices []uint64,
+		cells []eip7594.Cell,
+	) ([]eip7594.Cell, []eip4844.KZGProof, error)
+	// VerifyCellKZGProofBatch verifies that each cell is the cell at the
+	// given index of the extended blob committed to.
+	VerifyCellKZGProofBatch(*kzgtypes.CellProofArgs) error
 }
 
 // NewBlobProofVerifier creates a new BlobVerifier with the given
-// implementation.
+// implementation. It falls back to go-kzg-4844 when c-kzg-4844 is requested
+// but the executable was not built with it, so callers should check
+// GetImplementation.
 func NewBlobProofVerifier(
 	impl string,
 	ts *gokzg4844.JSONTrustedSetup,
@@ -58,6 +75,9 @@ func NewBlobProofVerifier(
 	case gokzg.Implementation:
 		return gokzg.NewVerifier(ts)
 	case ckzg.Implementation:
+		if !ckzg.Available {
+			return gokzg.NewVerifier(ts)
+		}
 		return ckzg.NewVerifier(ts)
 	default:
 		return nil, errors.Wrapf(
@@ -55,7 +55,12 @@ func TestNewBlobProofVerifier_CkzgImpl(t *testing.T) {
 	verifier, err := kzg.NewBlobProofVerifier(ckzg.Implementation, ts)
 	require.NoError(t, err)
 	require.NotNil(t, verifier)
-	require.Equal(t, ckzg.Implementation, verifier.GetImplementation())
+	if ckzg.Available {
+		require.Equal(t, ckzg.Implementation, verifier.GetImplementation())
+	} else {
+		// Without c-kzg-4844, the verifier falls back to go-kzg-4844.
+		require.Equal(t, gokzg.Implementation, verifier.GetImplementation())
+	}
 }
 
 func TestNewBlobProofVerifier_InvalidImpl(t *testing.T) {
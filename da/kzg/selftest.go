@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	_ "embed"
+	"time"
+
+	"github.com/berachain/beacon-kit/da/kzg/gokzg"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/spf13/afero"
+)
+
+// bytesPerFieldElement is the size of a field element of a blob.
+const bytesPerFieldElement = 32
+
+// builtinSelfTestVector is the vector used when no path is configured, a copy
+// of testing/files/test_data.json.
+//
+//go:embed selftest_vector.json
+var builtinSelfTestVector []byte
+
+// SelfTestVector is a blob along with its commitment and proof, in the
+// format of testing/files/test_data.json.
+type SelfTestVector struct {
+	Input struct {
+		Blob       *eip4844.Blob         `json:"blob"`
+		Commitment eip4844.KZGCommitment `json:"commitment"`
+		Proof      eip4844.KZGProof      `json:"proof"`
+	} `json:"input"`
+}
+
+// ReadSelfTestVector reads a SelfTestVector from the file system, or returns
+// the built-in one if filePath is empty.
+func ReadSelfTestVector(filePath string) (*SelfTestVector, error) {
+	bz := builtinSelfTestVector
+	if filePath != "" {
+		var err error
+		if bz, err = afero.ReadFile(afero.NewOsFs(), filePath); err != nil {
+			return nil, err
+		}
+	}
+	vec := new(SelfTestVector)
+	if err := json.Unmarshal(bz, vec); err != nil {
+		return nil, err
+	}
+	if vec.Input.Blob == nil {
+		return nil, errors.Wrapf(
+			ErrSelfTestFailed, "no blob in %s", filePath,
+		)
+	}
+	return vec, nil
+}
+
+// SelfTestResult is the outcome of the self-test of an implementation.
+type SelfTestResult struct {
+	// Implementation is the implementation tested.
+	Implementation string
+	// Latency is the time taken to verify the proof of the vector.
+	Latency time.Duration
+	// Err is set if the implementation failed the self-test.
+	Err error
+}
+
+// SelfTest checks that the verifier accepts the proof of the vector and
+// rejects it for a different blob.
+func SelfTest(v BlobProofVerifier, vec *SelfTestVector) SelfTestResult {
+	res := SelfTestResult{Implementation: v.GetImplementation()}
+	start := time.Now()
+	err := v.VerifyBlobProof(
+		vec.Input.Blob, vec.Input.Proof, vec.Input.Commitment,
+	)
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Err = errors.Wrapf(ErrSelfTestFailed, "valid proof: %v", err)
+		return res
+	}
+
+	// Flipping the lowest bit of the first field element keeps the blob
+	// canonical.
+	tampered := *vec.Input.Blob
+	tampered[bytesPerFieldElement-1] ^= 1
+	if v.VerifyBlobProof(
+		&tampered, vec.Input.Proof, vec.Input.Commitment,
+	) == nil {
+		res.Err = errors.Wrap(ErrSelfTestFailed, "tampered blob accepted")
+	}
+	return res
+}
+
+// NewSelfTestedBlobProofVerifier creates the verifier for impl, as
+// NewBlobProofVerifier, and self-tests it against vec. c-kzg-4844 falls back
+// to go-kzg-4844 when it fails the self-test. go-kzg-4844 is tested even when
+// c-kzg-4844 passes, so the latencies of both can be compared.
+func NewSelfTestedBlobProofVerifier(
+	impl string,
+	ts *gokzg4844.JSONTrustedSetup,
+	vec *SelfTestVector,
+) (BlobProofVerifier, []SelfTestResult, error) {
+	verifier, err := NewBlobProofVerifier(impl, ts)
+	if err != nil {
+		return nil, nil, err
+	}
+	res := SelfTest(verifier, vec)
+	results := []SelfTestResult{res}
+	if verifier.GetImplementation() == gokzg.Implementation {
+		return verifier, results, res.Err
+	}
+
+	fallback, err := gokzg.NewVerifier(ts)
+	if err != nil {
+		return nil, results, err
+	}
+	fallbackRes := SelfTest(fallback, vec)
+	results = append(results, fallbackRes)
+	switch {
+	case res.Err == nil:
+		return verifier, results, nil
+	case fallbackRes.Err == nil:
+		return fallback, results, nil
+	default:
+		return nil, results, errors.Join(res.Err, fallbackRes.Err)
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/da/kzg"
+	"github.com/berachain/beacon-kit/da/kzg/ckzg"
+	"github.com/berachain/beacon-kit/da/kzg/gokzg"
+	"github.com/berachain/beacon-kit/da/kzg/noop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfTest(t *testing.T) {
+	ts, err := loadTrustedSetupFromFile()
+	require.NoError(t, err)
+	vec, err := kzg.ReadSelfTestVector(
+		filepath.Join(baseDir, "test_data.json"),
+	)
+	require.NoError(t, err)
+
+	verifier, err := gokzg.NewVerifier(ts)
+	require.NoError(t, err)
+	res := kzg.SelfTest(verifier, vec)
+	require.NoError(t, res.Err)
+	require.Equal(t, gokzg.Implementation, res.Implementation)
+	require.Positive(t, res.Latency)
+
+	// A verifier accepting everything fails the self-test.
+	res = kzg.SelfTest(noop.NewVerifier(), vec)
+	require.ErrorIs(t, res.Err, kzg.ErrSelfTestFailed)
+
+	// So does a tampered proof.
+	vec.Input.Proof[0] ^= 1
+	res = kzg.SelfTest(verifier, vec)
+	require.ErrorIs(t, res.Err, kzg.ErrSelfTestFailed)
+}
+
+func TestReadSelfTestVector_Builtin(t *testing.T) {
+	builtin, err := kzg.ReadSelfTestVector("")
+	require.NoError(t, err)
+	vec, err := kzg.ReadSelfTestVector(
+		filepath.Join(baseDir, "test_data.json"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, vec, builtin)
+}
+
+func TestNewSelfTestedBlobProofVerifier(t *testing.T) {
+	ts, err := loadTrustedSetupFromFile()
+	require.NoError(t, err)
+	vec, err := kzg.ReadSelfTestVector(
+		filepath.Join(baseDir, "test_data.json"),
+	)
+	require.NoError(t, err)
+
+	verifier, results, err := kzg.NewSelfTestedBlobProofVerifier(
+		gokzg.Implementation, ts, vec,
+	)
+	require.NoError(t, err)
+	require.Equal(t, gokzg.Implementation, verifier.GetImplementation())
+	require.Len(t, results, 1)
+
+	_, _, err = kzg.NewSelfTestedBlobProofVerifier("invalid", ts, vec)
+	require.ErrorIs(t, err, kzg.ErrUnsupportedKzgImplementation)
+
+	// c-kzg-4844 can only be loaded once per process, so it is only
+	// exercised here when it is not built in.
+	if !ckzg.Available {
+		verifier, results, err = kzg.NewSelfTestedBlobProofVerifier(
+			ckzg.Implementation, ts, vec,
+		)
+		require.NoError(t, err)
+		require.Equal(t, gokzg.Implementation, verifier.GetImplementation())
+		require.Len(t, results, 1)
+	}
+}
+
+func TestReadSelfTestVector_Missing(t *testing.T) {
+	_, err := kzg.ReadSelfTestVector(filepath.Join(baseDir, "missing.json"))
+	require.Error(t, err)
+}
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 )
 
 type BlobSidecar interface {
@@ -44,3 +45,15 @@ type BlobProofArgs struct {
 	// Commitment is the KZG commitment.
 	Commitments []eip4844.KZGCommitment
 }
+
+// CellProofArgs represents the arguments for a batch of cell proofs.
+type CellProofArgs struct {
+	// Commitments are the KZG commitments of the blobs of the cells.
+	Commitments []eip4844.KZGCommitment
+	// CellIndices are the indices of the cells in their extended blob.
+	CellIndices []uint64
+	// Cells are the cells.
+	Cells []eip7594.Cell
+	// Proofs are the KZG proofs of the cells.
+	Proofs []eip4844.KZGProof
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
+)
+
+const (
+	cursorFilePerm = 0o600
+	cursorDirPerm  = 0o700
+)
+
+// firstIndexer is implemented by the IndexDBs which know the lowest index
+// they store values for.
+type firstIndexer interface {
+	FirstIndex() (uint64, bool, error)
+}
+
+// archiveLoop copies sidecars to the archive, in the background so that a
+// slow archive does not hold up block finalization, up to the latest target
+// requested by Prune. Slots below start, already pruned, are not archived.
+func (s *Store[BeaconBlockT]) archiveLoop(start uint64) {
+	defer close(s.archiverDone)
+	from, err := s.archiveStart(start)
+	if err != nil {
+		// Archiving is idempotent, starting over only costs time.
+		s.logger.Error("Failed to find the slot to archive from", "err", err)
+	}
+	s.archivedUpTo.Store(max(from, start))
+
+	for {
+		select {
+		case <-s.stopArchiver:
+			return
+		case <-s.archiveSignal:
+			if err = s.archiveUpTo(s.archiveTarget.Load()); err != nil {
+				s.logger.Error("Failed to archive blob sidecars", "err", err)
+			}
+		}
+	}
+}
+
+// archiveUpTo archives the sidecars of the slots from the cursor up to
+// target (excluded), advancing and persisting the cursor as it goes.
+func (s *Store[BeaconBlockT]) archiveUpTo(target uint64) error {
+	slot := s.archivedUpTo.Load()
+	for ; slot < target; slot++ {
+		select {
+		case <-s.stopArchiver:
+			return s.saveArchiveCursor(slot)
+		default:
+		}
+		archived, err := s.archiveSlot(slot)
+		if err != nil {
+			return err
+		}
+		// The cursor is persisted when it matters, i.e. not for every
+		// empty slot scanned.
+		if archived {
+			if err = s.saveArchiveCursor(slot + 1); err != nil {
+				return err
+			}
+		}
+		s.archivedUpTo.Store(slot + 1)
+	}
+	return s.saveArchiveCursor(slot)
+}
+
+// archiveStart returns the slot archiving starts from: the persisted cursor
+// if any, the first slot stored in the IndexDB otherwise, so that a new
+// archive does not scan every slot from genesis.
+func (s *Store[BeaconBlockT]) archiveStart(start uint64) (uint64, error) {
+	from, found, err := s.loadArchiveCursor()
+	if found || err != nil {
+		return from, err
+	}
+	db, ok := s.IndexDB.(firstIndexer)
+	if !ok {
+		return start, nil
+	}
+	first, found, err := db.FirstIndex()
+	if !found || err != nil {
+		return start, err
+	}
+	return first, nil
+}
+
+// loadArchiveCursor returns the slot up to which (excluded) sidecars have
+// been archived, and false if unknown.
+func (s *Store[BeaconBlockT]) loadArchiveCursor() (uint64, bool, error) {
+	if s.archiveCursorPath == "" {
+		return 0, false, nil
+	}
+	bz, err := os.ReadFile(s.archiveCursorPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	case len(bz) != int(constants.U64Size):
+		return 0, false, errors.Wrapf(
+			ErrInvalidArchiveCursor, "%s", s.archiveCursorPath,
+		)
+	}
+	return binary.LittleEndian.Uint64(bz), true, nil
+}
+
+// saveArchiveCursor atomically persists the slot up to which (excluded)
+// sidecars have been archived.
+func (s *Store[BeaconBlockT]) saveArchiveCursor(slot uint64) error {
+	if s.archiveCursorPath == "" {
+		return nil
+	}
+	if err := os.MkdirAll(
+		filepath.Dir(s.archiveCursorPath), cursorDirPerm,
+	); err != nil {
+		return err
+	}
+	bz := binary.LittleEndian.AppendUint64(nil, slot)
+	tmp := s.archiveCursorPath + ".tmp"
+	if err := os.WriteFile(tmp, bz, cursorFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.archiveCursorPath)
+}
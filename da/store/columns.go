@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"slices"
+
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ColumnStore stores the data column sidecars of the columns a node
+// custodies. Data is available once every custodied column is stored.
+type ColumnStore[BeaconBlockBodyT BeaconBlockBody] struct {
+	// IndexDB is a basic database interface.
+	IndexDB
+	// logger is used for logging.
+	logger log.Logger
+	// custody holds the sorted indices of the custodied columns.
+	custody []uint64
+}
+
+// NewColumnStore creates a new ColumnStore custodying the given columns, as
+// returned by types.CustodyColumns.
+func NewColumnStore[BeaconBlockBodyT BeaconBlockBody](
+	db IndexDB,
+	logger log.Logger,
+	custody []uint64,
+) *ColumnStore[BeaconBlockBodyT] {
+	custody = slices.Clone(custody)
+	slices.Sort(custody)
+	return &ColumnStore[BeaconBlockBodyT]{
+		IndexDB: db,
+		logger:  logger,
+		custody: slices.Compact(custody),
+	}
+}
+
+// Custody returns the sorted indices of the custodied columns.
+func (s *ColumnStore[_]) Custody() []uint64 {
+	return slices.Clone(s.custody)
+}
+
+// IsDataAvailable ensures that every custodied column of the block is
+// stored, if the block has blobs.
+func (s *ColumnStore[BeaconBlockBodyT]) IsDataAvailable(
+	_ context.Context,
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) bool {
+	if len(body.GetBlobKzgCommitments()) == 0 {
+		return true
+	}
+	for _, index := range s.custody {
+		found, err := s.IndexDB.Has(slot.Unwrap(), columnKey(index))
+		if err != nil || !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Persist stores the sidecars of the custodied columns and ignores the
+// others.
+func (s *ColumnStore[_]) Persist(
+	slot math.Slot,
+	sidecars []*types.DataColumnSidecar,
+) error {
+	var stored int
+	for _, sidecar := range sidecars {
+		if sidecar == nil {
+			return ErrAttemptedToStoreNilSidecar
+		}
+		if _, custodied := slices.BinarySearch(
+			s.custody, sidecar.Index,
+		); !custodied {
+			continue
+		}
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err = s.Set(slot.Unwrap(), columnKey(sidecar.Index), bz); err != nil {
+			return err
+		}
+		stored++
+	}
+
+	if stored > 0 {
+		s.logger.Info("Successfully stored data column sidecars 🚗",
+			"slot", slot.Base10(), "num_columns", stored,
+		)
+	}
+	return nil
+}
+
+// GetDataColumnSidecars returns the sidecars stored for slot, sorted by
+// column index.
+func (s *ColumnStore[_]) GetDataColumnSidecars(
+	slot math.Slot,
+) ([]*types.DataColumnSidecar, error) {
+	values, err := s.GetByIndex(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	sidecars := make([]*types.DataColumnSidecar, len(values))
+	for i, bz := range values {
+		sidecars[i] = new(types.DataColumnSidecar)
+		if err = sidecars[i].UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(sidecars, func(a, b *types.DataColumnSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return sidecars, nil
+}
+
+// columnKey returns the key a column is stored under.
+func columnKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, index)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func newColumns(slot uint64, indices ...uint64) []*types.DataColumnSidecar {
+	sidecars := make([]*types.DataColumnSidecar, len(indices))
+	for i, index := range indices {
+		sidecars[i] = &types.DataColumnSidecar{
+			Index:             index,
+			Column:            []*eip7594.Cell{{byte(index)}},
+			KzgCommitments:    []eip4844.KZGCommitment{{1}},
+			KzgProofs:         []eip4844.KZGProof{{byte(index)}},
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{Slot: math.Slot(slot)},
+			InclusionProof: make(
+				[]common.Root, types.KZGCommitmentsInclusionProofDepth,
+			),
+		}
+	}
+	return sidecars
+}
+
+func TestColumnStore(t *testing.T) {
+	s := store.NewColumnStore[*ctypes.BeaconBlockBody](
+		newRangeDB(t), noop.NewLogger[any](), []uint64{90, 3, 42, 3},
+	)
+	require.Equal(t, []uint64{3, 42, 90}, s.Custody())
+
+	body := &ctypes.BeaconBlockBody{
+		BlobKzgCommitments: []eip4844.KZGCommitment{{1}},
+	}
+	require.False(t, s.IsDataAvailable(context.Background(), 5, body))
+	require.True(t, s.IsDataAvailable(
+		context.Background(), 5, &ctypes.BeaconBlockBody{},
+	))
+
+	// Columns outside of custody are not stored.
+	require.NoError(t, s.Persist(5, newColumns(5, 1, 3, 42, 100)))
+	require.False(t, s.IsDataAvailable(context.Background(), 5, body))
+	require.NoError(t, s.Persist(5, newColumns(5, 90)))
+	require.True(t, s.IsDataAvailable(context.Background(), 5, body))
+
+	sidecars, err := s.GetDataColumnSidecars(5)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 3)
+	for i, index := range []uint64{3, 42, 90} {
+		require.Equal(t, index, sidecars[i].Index)
+		require.Equal(t, eip7594.Cell{byte(index)}, *sidecars[i].Column[0])
+	}
+
+	require.NoError(t, s.Prune(0, 10))
+	require.False(t, s.IsDataAvailable(context.Background(), 5, body))
+	require.ErrorIs(t,
+		s.Persist(6, []*types.DataColumnSidecar{nil}),
+		store.ErrAttemptedToStoreNilSidecar,
+	)
+}
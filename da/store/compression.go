@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionNone stores sidecars as raw SSZ.
+	CompressionNone = ""
+	// CompressionZstd compresses sidecars with zstd.
+	CompressionZstd = "zstd"
+	// CompressionSnappy compresses sidecars with snappy.
+	CompressionSnappy = "snappy"
+)
+
+const (
+	// compressedMagic prefixes compressed values, followed by the algorithm
+	// byte. Raw sidecars start with their little-endian blob index, which
+	// never begins with these bytes, so both formats can coexist.
+	compressedMagic = "\xffbkz"
+	// compressedHeaderSize is the size of the header of compressed values.
+	compressedHeaderSize = len(compressedMagic) + 1
+	// maxDecodedSize bounds the memory used to decompress a single value.
+	maxDecodedSize = 4 << 20
+)
+
+const (
+	// algorithmZstd marks values compressed with zstd.
+	algorithmZstd byte = iota + 1
+	// algorithmSnappy marks values compressed with snappy.
+	algorithmSnappy
+)
+
+// codec compresses values with the configured algorithm and decompresses
+// values in any supported format.
+type codec struct {
+	// compression is the name of the configured algorithm.
+	compression string
+	// encoder and decoder are safe for concurrent use.
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// ValidateCompression returns an error if compression is not a supported
+// algorithm.
+func ValidateCompression(compression string) error {
+	switch compression {
+	case CompressionNone, CompressionZstd, CompressionSnappy:
+		return nil
+	default:
+		return errors.Wrapf(ErrUnknownCompression, "%q", compression)
+	}
+}
+
+// newCodec creates a new codec compressing with the given algorithm.
+func newCodec(compression string) (*codec, error) {
+	if err := ValidateCompression(compression); err != nil {
+		return nil, err
+	}
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(
+		nil, zstd.WithDecoderMaxMemory(maxDecodedSize),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &codec{
+		compression: compression,
+		encoder:     encoder,
+		decoder:     decoder,
+	}, nil
+}
+
+// encode compresses value with the configured algorithm.
+func (c *codec) encode(value []byte) []byte {
+	var (
+		algorithm byte
+		header    = make([]byte, 0, compressedHeaderSize)
+	)
+	switch c.compression {
+	case CompressionZstd:
+		algorithm = algorithmZstd
+	case CompressionSnappy:
+		algorithm = algorithmSnappy
+	default:
+		return value
+	}
+	header = append(append(header, compressedMagic...), algorithm)
+
+	if algorithm == algorithmSnappy {
+		return append(header, snappy.Encode(nil, value)...)
+	}
+	return c.encoder.EncodeAll(value, header)
+}
+
+// decode decompresses value. Values that are not compressed are returned
+// unchanged.
+func (c *codec) decode(value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, []byte(compressedMagic)) ||
+		len(value) < compressedHeaderSize {
+		return value, nil
+	}
+	payload := value[compressedHeaderSize:]
+	switch algorithm := value[compressedHeaderSize-1]; algorithm {
+	case algorithmZstd:
+		return c.decoder.DecodeAll(payload, nil)
+	case algorithmSnappy:
+		if n, err := snappy.DecodedLen(payload); err != nil {
+			return nil, err
+		} else if n > maxDecodedSize {
+			return nil, snappy.ErrTooLarge
+		}
+		return snappy.Decode(nil, payload)
+	default:
+		return nil, errors.Wrapf(
+			ErrUnknownCompression, "algorithm %d", algorithm,
+		)
+	}
+}
@@ -34,4 +34,16 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrUnknownBackend is returned when the configured backend of the
+	// availability store is not known.
+	ErrUnknownBackend = errors.New("unknown availability store backend")
+
+	// ErrUnknownCompression is returned when a compression algorithm is not
+	// known.
+	ErrUnknownCompression = errors.New("unknown compression algorithm")
+
+	// ErrInvalidArchiveCursor is returned when the persisted archive cursor
+	// cannot be decoded.
+	ErrInvalidArchiveCursor = errors.New("invalid archive cursor")
 )
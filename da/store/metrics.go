@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+// storeMetrics is a struct that contains metrics for the store.
+type storeMetrics struct {
+	// sink is the sink for the metrics, if any.
+	sink TelemetrySink
+}
+
+// newStoreMetrics creates a new storeMetrics.
+func newStoreMetrics(sink TelemetrySink) *storeMetrics {
+	return &storeMetrics{
+		sink: sink,
+	}
+}
+
+// setCompressionRatio reports the stored size of the last persisted sidecars
+// in basis points of their raw size.
+func (sm *storeMetrics) setCompressionRatio(
+	compression string,
+	rawSize, storedSize int64,
+) {
+	if sm.sink == nil || compression == CompressionNone || rawSize == 0 {
+		return
+	}
+	sm.sink.SetGauge(
+		"beacon_kit.da.store.compression_ratio",
+		storedSize*10_000/rawSize, //nolint:mnd // basis points.
+		"algorithm",
+		compression,
+	)
+}
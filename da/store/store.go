@@ -21,7 +21,12 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/errors"
@@ -39,19 +44,120 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// archive is the optional cold store of sidecars.
+	archive Archive
+	// archiveCursorPath is the file the archive cursor is persisted to.
+	archiveCursorPath string
+	// archivedUpTo is the slot up to which (excluded) sidecars have been
+	// archived, and may therefore be pruned.
+	archivedUpTo atomic.Uint64
+	// archiveTarget is the slot up to which (excluded) sidecars are to be
+	// archived.
+	archiveTarget atomic.Uint64
+	// archiveSignal wakes the archiver up once archiveTarget moved.
+	archiveSignal chan struct{}
+	// startArchiver starts the archiver once.
+	startArchiver sync.Once
+	// stopArchiver is closed to stop the archiver.
+	stopArchiver chan struct{}
+	// archiverDone is closed once the archiver returned, or will not start.
+	archiverDone chan struct{}
+	// codec compresses sidecars at rest.
+	codec *codec
+	// metrics is used to collect and report store metrics.
+	metrics *storeMetrics
 }
 
-// New creates a new instance of the AvailabilityStore.
+// Option is a functional option for the Store.
+type Option func(*options)
+
+// options holds the optional settings of the Store.
+type options struct {
+	archive           Archive
+	archiveCursorPath string
+	compression       string
+	sink              TelemetrySink
+}
+
+// WithArchive makes the Store copy sidecars to archive before pruning them,
+// and read sidecars from archive once they are pruned. The slot up to which
+// sidecars have been archived is persisted to cursorPath, if not empty, so
+// that archiving resumes from there after a restart.
+func WithArchive(archive Archive, cursorPath string) Option {
+	return func(o *options) {
+		o.archive = archive
+		o.archiveCursorPath = cursorPath
+	}
+}
+
+// WithCompression makes the Store compress sidecars with the given algorithm,
+// one of CompressionNone, CompressionZstd or CompressionSnappy. Sidecars are
+// decompressed transparently, whatever format they were stored in.
+func WithCompression(compression string) Option {
+	return func(o *options) {
+		o.compression = compression
+	}
+}
+
+// WithTelemetrySink sets the sink the Store reports metrics to.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
+
+// New creates a new instance of the AvailabilityStore. It returns an error if
+// the compression algorithm is not supported.
 func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
 	logger log.Logger,
 	chainSpec common.ChainSpec,
-) *Store[BeaconBlockT] {
+	opts ...Option,
+) (*Store[BeaconBlockT], error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c, err := newCodec(o.compression)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create codec")
+	}
 	return &Store[BeaconBlockT]{
-		IndexDB:   db,
-		chainSpec: chainSpec,
-		logger:    logger,
+		IndexDB:           db,
+		chainSpec:         chainSpec,
+		logger:            logger,
+		archive:           o.archive,
+		archiveCursorPath: o.archiveCursorPath,
+		archiveSignal:     make(chan struct{}, 1),
+		stopArchiver:      make(chan struct{}),
+		archiverDone:      make(chan struct{}),
+		codec:             c,
+		metrics:           newStoreMetrics(o.sink),
+	}, nil
+}
+
+// Start is a no-op: the archiver is started by the first Prune.
+func (s *Store[BeaconBlockT]) Start(context.Context) error {
+	return nil
+}
+
+// Stop stops the archiver and closes the IndexDB, if it needs closing. It is
+// stopped after the services using it, as the last service of the node.
+func (s *Store[BeaconBlockT]) Stop() error {
+	// The archiver must not start once stopped.
+	s.startArchiver.Do(func() { close(s.archiverDone) })
+	close(s.stopArchiver)
+	<-s.archiverDone
+	if db, ok := s.IndexDB.(io.Closer); ok {
+		s.logger.Info("Closing the availability store")
+		return db.Close()
 	}
+	return nil
+}
+
+// Name returns the name of the availability store.
+func (s *Store[BeaconBlockT]) Name() string {
+	return "availability-store"
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -83,7 +189,9 @@ func (s *Store[BeaconBlockT]) Persist(
 	}
 
 	// Check to see if we are required to store the sidecar anymore, if
-	// this sidecar is from outside the required DA period, we can skip it.
+	// this sidecar is from outside the required DA period, we can skip it,
+	// unless it is archived.
+	var db Archive = s.IndexDB
 	if !s.chainSpec.WithinDAPeriod(
 		// slot in which the sidecar was included.
 		// (Safe to assume all sidecars are in same slot at this point).
@@ -91,10 +199,14 @@ func (s *Store[BeaconBlockT]) Persist(
 		// current slot
 		slot,
 	) {
-		return nil
+		if s.archive == nil {
+			return nil
+		}
+		db = s.archive
 	}
 
 	// Store each sidecar in parallel.
+	var rawSize, storedSize atomic.Int64
 	if err := errors.Join(iter.Map(
 		sidecars.Sidecars,
 		func(sidecar **types.BlobSidecar) error {
@@ -106,14 +218,103 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
+			rawSize.Add(int64(len(bz)))
+			bz = s.codec.encode(bz)
+			storedSize.Add(int64(len(bz)))
+			return db.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
 		},
 	)...); err != nil {
 		return err
 	}
+	s.metrics.setCompressionRatio(
+		s.codec.compression, rawSize.Load(), storedSize.Load(),
+	)
 
 	s.logger.Info("Successfully stored all blob sidecars 🚗",
 		"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
 	)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars stored for slot, reading them from the
+// archive if they were pruned from the IndexDB.
+func (s *Store[BeaconBlockT]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	values, err := s.GetByIndex(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 && s.archive != nil {
+		if values, err = s.archive.GetByIndex(slot.Unwrap()); err != nil {
+			return nil, err
+		}
+	}
+
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, len(values)),
+	}
+	for i, bz := range values {
+		if bz, err = s.codec.decode(bz); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars[i] = new(types.BlobSidecar)
+		if err = sidecars.Sidecars[i].UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(sidecars.Sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return sidecars, nil
+}
+
+// Prune removes the sidecars of slots [start, end) from the IndexDB. With an
+// archive, sidecars are copied to it in the background first, and only the
+// slots archived so far are pruned: the others are pruned by a later call.
+func (s *Store[BeaconBlockT]) Prune(start, end uint64) error {
+	if s.archive != nil {
+		s.startArchiver.Do(func() { go s.archiveLoop(start) })
+		if end > s.archiveTarget.Load() {
+			s.archiveTarget.Store(end)
+			select {
+			case s.archiveSignal <- struct{}{}:
+			default:
+				// The archiver is already due to pick the new target up.
+			}
+		}
+		end = min(end, s.archivedUpTo.Load())
+		if start >= end {
+			return nil
+		}
+	}
+	return s.IndexDB.Prune(start, end)
+}
+
+// archiveSlot copies the sidecars of slot to the archive, returning whether
+// there were any.
+func (s *Store[BeaconBlockT]) archiveSlot(slot uint64) (bool, error) {
+	values, err := s.GetByIndex(slot)
+	if err != nil {
+		return false, err
+	}
+	for _, bz := range values {
+		var raw []byte
+		if raw, err = s.codec.decode(bz); err != nil {
+			return false, err
+		}
+		sc := new(types.BlobSidecar)
+		if err = sc.UnmarshalSSZ(raw); err != nil {
+			return false, err
+		}
+		if err = s.archive.Set(slot, sc.KzgCommitment[:], bz); err != nil {
+			return false, err
+		}
+	}
+	if len(values) > 0 {
+		s.logger.Info("Archived blob sidecars 🧊",
+			"slot", slot, "num_sidecars", len(values),
+		)
+	}
+	return len(values) > 0, nil
+}
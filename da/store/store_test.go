@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"context"
+	stdmath "math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func newRangeDB(t *testing.T) *filedb.RangeDB {
+	t.Helper()
+	return filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(t.TempDir()),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(noop.NewLogger[any]()),
+	))
+}
+
+func newSidecars(slot math.Slot, count int) *types.BlobSidecars {
+	sidecars := &types.BlobSidecars{}
+	for i := range count {
+		sidecars.Sidecars = append(sidecars.Sidecars, types.BuildBlobSidecar(
+			math.U64(count-1-i),
+			&ctypes.BeaconBlockHeader{Slot: slot},
+			&eip4844.Blob{byte(i)},
+			eip4844.KZGCommitment{byte(count - 1 - i)},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		))
+	}
+	return sidecars
+}
+
+func TestStore_PruneArchivesSidecars(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	archive := newRangeDB(t)
+	s, err := store.New[*ctypes.BeaconBlockBody](
+		newRangeDB(t), noop.NewLogger[any](), cs,
+		store.WithArchive(archive, filepath.Join(t.TempDir(), "cursor")),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Persist(5, newSidecars(5, 3)))
+	sidecars, err := s.GetBlobSidecars(5)
+	require.NoError(t, err)
+	require.Len(t, sidecars.Sidecars, 3)
+
+	// Sidecars are archived in the background, and pruned once archived.
+	requirePruned(t, s, 5, 10)
+
+	// Pruned sidecars are read back from the archive, sorted by index.
+	sidecars, err = s.GetBlobSidecars(5)
+	require.NoError(t, err)
+	require.Len(t, sidecars.Sidecars, 3)
+	for i, sc := range sidecars.Sidecars {
+		require.Equal(t, uint64(i), sc.Index)
+		require.Equal(t, math.Slot(5), sc.BeaconBlockHeader.GetSlot())
+	}
+}
+
+func TestStore_ArchiveCursorIsPersisted(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	cursor := filepath.Join(t.TempDir(), "cursor")
+	db := newRangeDB(t)
+	s, err := store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger[any](), cs,
+		store.WithArchive(newRangeDB(t), cursor),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Persist(5, newSidecars(5, 2)))
+	requirePruned(t, s, 5, 10)
+
+	// After a restart, archiving resumes from the cursor: slots below it
+	// are not archived again but pruned straight away.
+	archive := newRangeDB(t)
+	s, err = store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger[any](), cs, store.WithArchive(archive, cursor),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Persist(7, newSidecars(7, 2)))
+	requirePruned(t, s, 7, 8)
+	archived, err := archive.GetByIndex(7)
+	require.NoError(t, err)
+	require.Empty(t, archived)
+}
+
+// scanDB records the lowest index read from its RangeDB.
+type scanDB struct {
+	*filedb.RangeDB
+	lowest atomic.Uint64
+}
+
+func (db *scanDB) GetByIndex(index uint64) ([][]byte, error) {
+	if index < db.lowest.Load() {
+		db.lowest.Store(index)
+	}
+	return db.RangeDB.GetByIndex(index)
+}
+
+func TestStore_ArchiveStartsFromFirstStoredSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	db := &scanDB{RangeDB: newRangeDB(t)}
+	db.lowest.Store(stdmath.MaxUint64)
+	archive := newRangeDB(t)
+	s, err := store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger[any](), cs,
+		store.WithArchive(archive, filepath.Join(t.TempDir(), "cursor")),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Persist(1000, newSidecars(1000, 2)))
+
+	// Without a cursor, archiving starts from the first stored slot
+	// rather than from genesis.
+	requirePruned(t, s, 1000, 1010)
+	archived, err := archive.GetByIndex(1000)
+	require.NoError(t, err)
+	require.Len(t, archived, 2)
+	require.GreaterOrEqual(t, db.lowest.Load(), uint64(1000))
+}
+
+func TestStore_StopStopsArchiver(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	for _, prune := range []bool{false, true} {
+		s, err := store.New[*ctypes.BeaconBlockBody](
+			newRangeDB(t), noop.NewLogger[any](), cs,
+			store.WithArchive(newRangeDB(t), ""),
+		)
+		require.NoError(t, err)
+		if prune {
+			require.NoError(t, s.Prune(0, 10))
+		}
+		require.NoError(t, s.Stop())
+	}
+}
+
+// requirePruned prunes s up to end until the sidecars of slot are gone from
+// its IndexDB.
+func requirePruned(
+	t *testing.T,
+	s *store.Store[*ctypes.BeaconBlockBody],
+	slot, end uint64,
+) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		require.NoError(t, s.Prune(0, end))
+		hot, err := s.GetByIndex(slot)
+		require.NoError(t, err)
+		return len(hot) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStore_PruneWithoutArchive(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	s, err := store.New[*ctypes.BeaconBlockBody](
+		newRangeDB(t), noop.NewLogger[any](), cs,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Persist(5, newSidecars(5, 2)))
+	require.NoError(t, s.Prune(0, 10))
+	sidecars, err := s.GetBlobSidecars(5)
+	require.NoError(t, err)
+	require.Empty(t, sidecars.Sidecars)
+}
+
+type gaugeSink struct {
+	gauges map[string]int64
+}
+
+func (s *gaugeSink) SetGauge(key string, value int64, args ...string) {
+	s.gauges[key+"/"+strings.Join(args, "/")] = value
+}
+
+func TestStore_Compression(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	db := newRangeDB(t)
+
+	// Sidecars stored before compression was enabled stay readable.
+	raw, err := store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger[any](), cs,
+	)
+	require.NoError(t, err)
+	require.NoError(t, raw.Persist(4, newSidecars(4, 2)))
+
+	for _, compression := range []string{
+		store.CompressionZstd, store.CompressionSnappy,
+	} {
+		sink := &gaugeSink{gauges: make(map[string]int64)}
+		s, err := store.New[*ctypes.BeaconBlockBody](
+			db, noop.NewLogger[any](), cs,
+			store.WithCompression(compression),
+			store.WithTelemetrySink(sink),
+		)
+		require.NoError(t, err)
+		require.NoError(t, s.Persist(5, newSidecars(5, 3)))
+
+		// Mostly zero blobs compress well.
+		ratio := sink.gauges["beacon_kit.da.store.compression_ratio/algorithm/"+
+			compression]
+		require.Positive(t, ratio)
+		require.Less(t, ratio, int64(1_000))
+		values, err := s.GetByIndex(5)
+		require.NoError(t, err)
+		require.Less(t, len(values[0]), 1<<14)
+
+		for slot, count := range map[math.Slot]int{4: 2, 5: 3} {
+			sidecars, err := s.GetBlobSidecars(slot)
+			require.NoError(t, err)
+			require.Len(t, sidecars.Sidecars, count)
+			require.Equal(t, byte(1), sidecars.Sidecars[count-2].Blob[0])
+		}
+		require.True(t, s.IsDataAvailable(
+			context.Background(), 5, &ctypes.BeaconBlockBody{},
+		))
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	require.NoError(t, store.ValidateCompression(store.CompressionNone))
+	require.NoError(t, store.ValidateCompression(store.CompressionZstd))
+	require.ErrorIs(
+		t, store.ValidateCompression("lz4"), store.ErrUnknownCompression,
+	)
+
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, err = store.New[*ctypes.BeaconBlockBody](
+		newRangeDB(t), noop.NewLogger[any](), cs, store.WithCompression("lz4"),
+	)
+	require.ErrorIs(t, err, store.ErrUnknownCompression)
+}
@@ -40,11 +40,29 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 type IndexDB interface {
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// GetByIndex returns every value stored for index.
+	GetByIndex(index uint64) ([][]byte, error)
 
 	// Prune returns error if start > end
 	Prune(start uint64, end uint64) error
 }
 
+// Archive is a cold store sidecars are copied to before they are pruned
+// from the IndexDB.
+type Archive interface {
+	// Set stores value under key for index.
+	Set(index uint64, key []byte, value []byte) error
+	// GetByIndex returns every value stored for index.
+	GetByIndex(index uint64) ([][]byte, error)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
 // BeaconBlockBody is the body of a beacon block.
 type BeaconBlockBody interface {
 	// GetBlobKzgCommitments returns the KZG commitments for the blob.
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"slices"
+
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+)
+
+// CustodyColumns returns the sorted indices of the columns a node custodies,
+// as per get_custody_groups, nodeID being the little-endian encoding of the
+// 256-bit node identifier. custodyGroupCount is capped to the number of
+// custody groups.
+func CustodyColumns(nodeID [32]byte, custodyGroupCount uint64) []uint64 {
+	custodyGroupCount = min(
+		custodyGroupCount, eip7594.NumberOfCustodyGroups,
+	)
+	groups := make([]uint64, 0, custodyGroupCount)
+	seen := make(map[uint64]struct{}, custodyGroupCount)
+	current := nodeID
+	for uint64(len(groups)) < custodyGroupCount {
+		sum := sha256.Hash(current[:])
+		group := binary.LittleEndian.Uint64(sum[:8]) %
+			eip7594.NumberOfCustodyGroups
+		if _, found := seen[group]; !found {
+			seen[group] = struct{}{}
+			groups = append(groups, group)
+		}
+		increment(&current)
+	}
+	// Each custody group holds a single column.
+	slices.Sort(groups)
+	return groups
+}
+
+// increment adds one to the little-endian 256-bit integer, wrapping to zero.
+func increment(id *[32]byte) {
+	for i := range id {
+		id[i]++
+		if id[i] != 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// maxBlobCommitmentsPerBlock is the maximum number of cells in a column,
+	// one per blob of the block.
+	maxBlobCommitmentsPerBlock = 16
+	// KZGCommitmentsInclusionProofDepth is the depth of the proof of the KZG
+	// commitments in the beacon block body.
+	KZGCommitmentsInclusionProofDepth = 3
+)
+
+// DataColumnSidecar as per the EIP-7594 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#datacolumnsidecar
+type DataColumnSidecar struct {
+	// Index is the index of the column in the extended data matrix.
+	Index uint64
+	// Column holds the cell at Index of the extended blob of every blob in
+	// the block.
+	Column []*eip7594.Cell
+	// KzgCommitments are the KZG commitments of the blobs of the block.
+	KzgCommitments []eip4844.KZGCommitment
+	// KzgProofs are the KZG proofs of the cells of the column.
+	KzgProofs []eip4844.KZGProof
+	// BeaconBlockHeader represents the beacon block header for which this
+	// column is being included.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader
+	// InclusionProof is the inclusion proof of the KZG commitments in the
+	// beacon block body.
+	InclusionProof []common.Root
+}
+
+// BuildDataColumnSidecars creates the data column sidecars of a block from
+// the cells and cell proofs of each of its blobs.
+func BuildDataColumnSidecars(
+	header *ctypes.BeaconBlockHeader,
+	commitments []eip4844.KZGCommitment,
+	cells [][]eip7594.Cell,
+	proofs [][]eip4844.KZGProof,
+	inclusionProof []common.Root,
+) ([]*DataColumnSidecar, error) {
+	if len(cells) != len(commitments) || len(proofs) != len(commitments) {
+		return nil, errors.Wrapf(
+			ErrInvalidDataColumnSidecar,
+			"%d commitments, %d blobs of cells, %d blobs of proofs",
+			len(commitments), len(cells), len(proofs),
+		)
+	}
+	for i := range cells {
+		if len(cells[i]) != eip7594.NumberOfColumns ||
+			len(proofs[i]) != eip7594.NumberOfColumns {
+			return nil, errors.Wrapf(
+				ErrInvalidDataColumnSidecar,
+				"blob %d has %d cells and %d proofs",
+				i, len(cells[i]), len(proofs[i]),
+			)
+		}
+	}
+
+	sidecars := make([]*DataColumnSidecar, eip7594.NumberOfColumns)
+	for index := range sidecars {
+		sidecar := &DataColumnSidecar{
+			//#nosec:G115 // index is below NumberOfColumns.
+			Index:             uint64(index),
+			Column:            make([]*eip7594.Cell, len(cells)),
+			KzgCommitments:    commitments,
+			KzgProofs:         make([]eip4844.KZGProof, len(cells)),
+			BeaconBlockHeader: header,
+			InclusionProof:    inclusionProof,
+		}
+		for blob := range cells {
+			sidecar.Column[blob] = &cells[blob][index]
+			sidecar.KzgProofs[blob] = proofs[blob][index]
+		}
+		sidecars[index] = sidecar
+	}
+	return sidecars, nil
+}
+
+// Validate checks that the sidecar is well formed, as per
+// verify_data_column_sidecar.
+func (d *DataColumnSidecar) Validate() error {
+	switch {
+	case d.Index >= eip7594.NumberOfColumns:
+		return errors.Wrapf(
+			ErrInvalidDataColumnSidecar, "column index %d", d.Index,
+		)
+	case len(d.KzgCommitments) == 0:
+		return errors.Wrap(ErrInvalidDataColumnSidecar, "no commitments")
+	case slices.Contains(d.Column, nil):
+		return errors.Wrap(ErrInvalidDataColumnSidecar, "nil cell")
+	case len(d.Column) != len(d.KzgCommitments) ||
+		len(d.KzgProofs) != len(d.KzgCommitments):
+		return errors.Wrapf(
+			ErrInvalidDataColumnSidecar,
+			"%d cells, %d commitments, %d proofs",
+			len(d.Column), len(d.KzgCommitments), len(d.KzgProofs),
+		)
+	case d.BeaconBlockHeader == nil:
+		return errors.Wrap(ErrInvalidDataColumnSidecar, "nil header")
+	}
+	return nil
+}
+
+// HasValidInclusionProof verifies the inclusion proof of the KZG commitments
+// in the beacon block body, kzgPosition being the position of the
+// commitments among the fields of the body.
+func (d *DataColumnSidecar) HasValidInclusionProof(
+	kzgPosition uint64,
+	maxBlobCommitmentsPerBlock uint64,
+) bool {
+	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		eip4844.KZGCommitments[common.ExecutionHash](
+			d.KzgCommitments,
+		).Leafify(),
+		maxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return false
+	}
+	return merkle.IsValidMerkleBranch(
+		tree.HashTreeRoot(),
+		d.InclusionProof,
+		KZGCommitmentsInclusionProofDepth,
+		kzgPosition,
+		d.BeaconBlockHeader.BodyRoot,
+	)
+}
+
+// CellProofArgs returns the arguments to verify the KZG proofs of the cells
+// of the column.
+func (d *DataColumnSidecar) CellProofArgs() *kzgtypes.CellProofArgs {
+	args := &kzgtypes.CellProofArgs{
+		Commitments: d.KzgCommitments,
+		CellIndices: make([]uint64, len(d.Column)),
+		Cells:       make([]eip7594.Cell, len(d.Column)),
+		Proofs:      d.KzgProofs,
+	}
+	for i, cell := range d.Column {
+		args.CellIndices[i] = d.Index
+		args.Cells[i] = *cell
+	}
+	return args
+}
+
+// GetBeaconBlockHeader returns the header of the block of the column.
+func (d *DataColumnSidecar) GetBeaconBlockHeader() *ctypes.BeaconBlockHeader {
+	return d.BeaconBlockHeader
+}
+
+// SizeSSZ returns the size of the DataColumnSidecar object in SSZ encoding.
+func (d *DataColumnSidecar) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 8 + // Index
+		4 + // Column offset
+		4 + // KzgCommitments offset
+		4 + // KzgProofs offset
+		112 + // BeaconBlockHeader
+		KZGCommitmentsInclusionProofDepth*32 // InclusionProof
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(siz, d.Column)
+	size += ssz.SizeSliceOfStaticBytes(siz, d.KzgCommitments)
+	size += ssz.SizeSliceOfStaticBytes(siz, d.KzgProofs)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the DataColumnSidecar object.
+func (d *DataColumnSidecar) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &d.Index)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &d.Column, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineStaticObject(codec, &d.BeaconBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &d.InclusionProof, KZGCommitmentsInclusionProofDepth,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &d.Column, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// MarshalSSZ marshals the DataColumnSidecar object to SSZ format.
+func (d *DataColumnSidecar) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(d))
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DataColumnSidecar object from SSZ format.
+func (d *DataColumnSidecar) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DataColumnSidecar
+// object.
+func (d *DataColumnSidecar) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/blob"
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDataColumnSidecars(t *testing.T) {
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	factory := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+	](chainSpec, ctypes.KZGPositionDeneb, metrics.NewNoOpTelemetrySink())
+
+	const numBlobs = 3
+	blk := &ctypes.BeaconBlock{
+		Slot: 7,
+		Body: (&ctypes.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	cells := make([][]eip7594.Cell, numBlobs)
+	proofs := make([][]eip4844.KZGProof, numBlobs)
+	for i := range numBlobs {
+		blk.Body.BlobKzgCommitments = append(
+			blk.Body.BlobKzgCommitments, eip4844.KZGCommitment{byte(i + 1)},
+		)
+		cells[i] = make([]eip7594.Cell, eip7594.NumberOfColumns)
+		proofs[i] = make([]eip4844.KZGProof, eip7594.NumberOfColumns)
+		for j := range eip7594.NumberOfColumns {
+			cells[i][j][0], cells[i][j][1] = byte(i), byte(j)
+			proofs[i][j][0], proofs[i][j][1] = byte(i), byte(j)
+		}
+	}
+
+	sidecars, err := factory.BuildDataColumnSidecars(blk, cells, proofs)
+	require.NoError(t, err)
+	require.Len(t, sidecars, eip7594.NumberOfColumns)
+
+	sidecar := sidecars[42]
+	require.NoError(t, sidecar.Validate())
+	require.Equal(t, uint64(42), sidecar.Index)
+	require.Len(t, sidecar.Column, numBlobs)
+	require.Equal(t, cells[2][42], *sidecar.Column[2])
+	require.Equal(t, proofs[1][42], sidecar.KzgProofs[1])
+	require.True(t, sidecar.HasValidInclusionProof(
+		ctypes.KZGPositionDeneb, chainSpec.MaxBlobCommitmentsPerBlock(),
+	))
+	args := sidecar.CellProofArgs()
+	require.Equal(t, []uint64{42, 42, 42}, args.CellIndices)
+	require.Equal(t, cells[0][42], args.Cells[0])
+
+	// The proof does not hold for other commitments.
+	tampered := *sidecar
+	tampered.KzgCommitments = tampered.KzgCommitments[:2]
+	require.False(t, tampered.HasValidInclusionProof(
+		ctypes.KZGPositionDeneb, chainSpec.MaxBlobCommitmentsPerBlock(),
+	))
+	require.ErrorIs(t, tampered.Validate(), types.ErrInvalidDataColumnSidecar)
+
+	// Every blob needs a cell and a proof per column.
+	_, err = factory.BuildDataColumnSidecars(blk, cells[:2], proofs[:2])
+	require.ErrorIs(t, err, types.ErrInvalidDataColumnSidecar)
+}
+
+func TestDataColumnSidecarMarshalling(t *testing.T) {
+	sidecar := &types.DataColumnSidecar{
+		Index: 3,
+		Column: []*eip7594.Cell{
+			{1, 2, 3}, {4, 5, 6},
+		},
+		KzgCommitments:    []eip4844.KZGCommitment{{7}, {8}},
+		KzgProofs:         []eip4844.KZGProof{{9}, {10}},
+		BeaconBlockHeader: &ctypes.BeaconBlockHeader{Slot: 11},
+		InclusionProof:    make([]common.Root, types.KZGCommitmentsInclusionProofDepth),
+	}
+	sidecar.InclusionProof[0] = common.Root{12}
+
+	bz, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, 8+4+4+4+112+3*32+2*(eip7594.BytesPerCell+48+48))
+
+	unmarshalled := new(types.DataColumnSidecar)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, sidecar, unmarshalled)
+	require.Equal(t, sidecar.HashTreeRoot(), unmarshalled.HashTreeRoot())
+}
+
+func TestCustodyColumns(t *testing.T) {
+	nodeID := [32]byte{1, 2, 3}
+	columns := types.CustodyColumns(nodeID, eip7594.CustodyRequirement)
+	require.Len(t, columns, eip7594.CustodyRequirement)
+	require.IsIncreasing(t, columns)
+	for _, column := range columns {
+		require.Less(t, column, uint64(eip7594.NumberOfColumns))
+	}
+
+	// Custody is deterministic and grows monotonically with the count.
+	require.Equal(t,
+		columns, types.CustodyColumns(nodeID, eip7594.CustodyRequirement),
+	)
+	require.Subset(t, types.CustodyColumns(nodeID, 16), columns)
+	require.Len(t,
+		types.CustodyColumns(nodeID, 1000), eip7594.NumberOfCustodyGroups,
+	)
+
+	// The identifier wraps around at the maximum value.
+	maxID := [32]byte{}
+	for i := range maxID {
+		maxID[i] = 0xff
+	}
+	require.Len(t, types.CustodyColumns(maxID, 8), 8)
+}
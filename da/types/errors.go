@@ -38,4 +38,8 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrInvalidDataColumnSidecar is returned when a data column sidecar is
+	// malformed.
+	ErrInvalidDataColumnSidecar = errors.New("invalid data column sidecar")
 )
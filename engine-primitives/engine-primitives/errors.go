@@ -26,6 +26,10 @@ var (
 	// ErrInvalidTimestamp indicates that the provided timestamp is not valid.
 	ErrInvalidTimestamp = errors.New("invalid timestamp")
 
+	// ErrInvalidGasLimit indicates that the gas limit of a payload is out of
+	// the bounds allowed from its parent.
+	ErrInvalidGasLimit = errors.New("invalid gas limit")
+
 	// ErrNilWithdrawals indicates that the withdrawals are in a
 	// Capella versioned payload.
 	ErrNilWithdrawals = errors.New("nil withdrawals post capella")
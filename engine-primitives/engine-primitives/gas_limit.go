@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import "github.com/berachain/beacon-kit/errors"
+
+const (
+	// GasLimitBoundDivisor is the bound divisor of the gas limit, used to
+	// cap the change of gas limit between a block and its parent.
+	GasLimitBoundDivisor = 1024
+
+	// MinGasLimit is the minimum gas limit of a block.
+	MinGasLimit = 5000
+)
+
+// VerifyGasLimit ensures that the gas limit of a block is within the bound
+// allowed from the gas limit of its parent, as enforced by the execution
+// layer.
+func VerifyGasLimit(parentGasLimit, gasLimit uint64) error {
+	if gasLimit < MinGasLimit {
+		return errors.Wrapf(
+			ErrInvalidGasLimit, "%d below minimum %d", gasLimit, MinGasLimit,
+		)
+	}
+
+	diff := max(gasLimit, parentGasLimit) - min(gasLimit, parentGasLimit)
+	if limit := parentGasLimit / GasLimitBoundDivisor; diff >= limit {
+		return errors.Wrapf(
+			ErrInvalidGasLimit,
+			"%d moves parent gas limit %d by %d, limit %d",
+			gasLimit, parentGasLimit, diff, limit,
+		)
+	}
+	return nil
+}
+
+// CalcGasLimit returns the gas limit of a block built on top of a parent with
+// the given gas limit, moving it as much as allowed towards the desired gas
+// limit.
+func CalcGasLimit(parentGasLimit, desiredGasLimit uint64) uint64 {
+	desiredGasLimit = max(desiredGasLimit, MinGasLimit)
+	delta := max(parentGasLimit/GasLimitBoundDivisor, 1) - 1
+	switch {
+	case parentGasLimit < desiredGasLimit:
+		return min(parentGasLimit+delta, desiredGasLimit)
+	case parentGasLimit > desiredGasLimit:
+		return max(parentGasLimit-delta, desiredGasLimit)
+	default:
+		return parentGasLimit
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyGasLimit(t *testing.T) {
+	const parent = 30_000_000
+	tests := []struct {
+		name     string
+		gasLimit uint64
+		valid    bool
+	}{
+		{name: "unchanged", gasLimit: parent, valid: true},
+		{name: "max increase", gasLimit: parent + parent/1024 - 1, valid: true},
+		{name: "max decrease", gasLimit: parent - parent/1024 + 1, valid: true},
+		{name: "increase too large", gasLimit: parent + parent/1024},
+		{name: "decrease too large", gasLimit: parent - parent/1024},
+		{name: "below minimum", gasLimit: engineprimitives.MinGasLimit - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := engineprimitives.VerifyGasLimit(parent, tt.gasLimit)
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, engineprimitives.ErrInvalidGasLimit)
+		})
+	}
+}
+
+func TestCalcGasLimit(t *testing.T) {
+	const parent = 30_000_000
+	tests := []struct {
+		name     string
+		desired  uint64
+		expected uint64
+	}{
+		{name: "at target", desired: parent, expected: parent},
+		{name: "raise", desired: 60_000_000, expected: parent + parent/1024 - 1},
+		{name: "lower", desired: 15_000_000, expected: parent - parent/1024 + 1},
+		{name: "raise to close target", desired: parent + 10, expected: parent + 10},
+		{name: "lower to close target", desired: parent - 10, expected: parent - 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gasLimit := engineprimitives.CalcGasLimit(parent, tt.desired)
+			require.Equal(t, tt.expected, gasLimit)
+			require.NoError(t, engineprimitives.VerifyGasLimit(parent, gasLimit))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // NewPayloadRequest as per the Ethereum 2.0 specification:
@@ -67,6 +68,11 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests is the list of type-prefixed EIP-7685 requests of
+	// the payload, which are only sent from Electra.
+	ExecutionRequests []bytes.Bytes
+	// ForkVersion is the fork version of the block carrying the payload.
+	ForkVersion uint32
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
@@ -109,12 +115,16 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 	optimistic bool,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ExecutionRequests:     executionRequests,
+		ForkVersion:           forkVersion,
 		Optimistic:            optimistic,
 	}
 }
@@ -177,7 +187,7 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 
 	// Verify that the payload is telling the truth about it's block hash.
 	//#nosec:G103 // its okay.
-	if block := gethprimitives.NewBlockWithHeader(
+	block := gethprimitives.NewBlockWithHeader(
 		&gethprimitives.Header{
 			ParentHash:       gethprimitives.ExecutionHash(payload.GetParentHash()),
 			UncleHash:        gethprimitives.EmptyUncleHash,
@@ -201,10 +211,23 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 		},
 	).WithBody(gethprimitives.Body{
 		Transactions: txs, Uncles: nil, Withdrawals: *(*gethprimitives.Withdrawals)(unsafe.Pointer(&wds)),
-	}); common.ExecutionHash(block.Hash()) != payload.GetBlockHash() {
+	})
+
+	// From Electra the block header also commits to the execution requests.
+	blockHash := block.Hash()
+	if n.ForkVersion >= version.Electra {
+		var err error
+		if blockHash, err = gethprimitives.PragueHeaderHash(
+			block.Header(),
+			gethprimitives.CalcRequestsHash(n.ExecutionRequests),
+		); err != nil {
+			return err
+		}
+	}
+	if common.ExecutionHash(blockHash) != payload.GetBlockHash() {
 		return errors.Wrapf(ErrPayloadBlockHashMismatch,
 			"%x, got %x",
-			payload.GetBlockHash(), block.Hash(),
+			payload.GetBlockHash(), blockHash,
 		)
 	}
 	return nil
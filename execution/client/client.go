@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/errors"
@@ -34,14 +35,19 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// EngineClient is a struct that holds the Eth1Clients of the primary and
+// backup execution clients.
 type EngineClient[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
 ] struct {
-	*ethclient.Client[ExecutionPayloadT]
+	// endpoints are the execution clients, primary first.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// active is the endpoint calls are answered by.
+	active atomic.Pointer[endpoint[ExecutionPayloadT]]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -73,22 +79,44 @@ func New[
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
-	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:    cfg,
-		logger: logger,
-		Client: ethclient.New[ExecutionPayloadT](
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
+	s := &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
+		cfg:          cfg,
+		logger:       logger,
 		capabilities: make(map[string]struct{}),
 		eth1ChainID:  eth1ChainID,
 		metrics:      newClientMetrics(telemetrySink, logger),
 		connected:    false,
 	}
+	retryPolicy := &ethclientrpc.RetryPolicy{
+		//#nosec:G115 // the number of retries is small.
+		MaxRetries:  int(cfg.RPCRetries),
+		BaseBackoff: cfg.RPCRetryBackoff,
+		MaxBackoff:  cfg.RPCRetryMaxBackoff,
+		Methods:     ethclient.IdempotentMethods(),
+	}
+	for i, dialURL := range cfg.DialURLs() {
+		breaker := ethclientrpc.NewBreaker(
+			cfg.RPCBreakerThreshold,
+			cfg.RPCBreakerCooldown,
+			s.onBreakerStateChange(i, dialURL),
+		)
+		s.endpoints = append(s.endpoints, &endpoint[ExecutionPayloadT]{
+			url:     dialURL,
+			breaker: breaker,
+			client: ethclient.New[ExecutionPayloadT](
+				ethclientrpc.NewClient(
+					dialURL.String(),
+					ethclientrpc.WithJWTSecret(jwtSecret),
+					ethclientrpc.WithJWTRefreshInterval(
+						cfg.RPCJWTRefreshInterval,
+					),
+					ethclientrpc.WithRetryPolicy(retryPolicy),
+					ethclientrpc.WithBreaker(breaker),
+				)),
+		})
+	}
+	s.active.Store(s.endpoints[0])
+	return s
 }
 
 // Name returns the name of the engine client.
@@ -104,17 +132,24 @@ func (s *EngineClient[
 ]) Start(
 	ctx context.Context,
 ) error {
-	// Start the Client.
-	go s.Client.Start(ctx)
+	// Start the Clients.
+	for _, e := range s.endpoints {
+		go e.client.Start(ctx)
+	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"num_backups", len(s.cfg.RPCBackupDialURLs),
 	)
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
 	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+		s.connectedMu.Lock()
+		s.connected = true
+		s.connectedMu.Unlock()
+		s.startHealthChecks(ctx)
 		return nil
 	}
 
@@ -139,6 +174,7 @@ func (s *EngineClient[
 			s.connectedMu.Lock()
 			s.connected = true
 			s.connectedMu.Unlock()
+			s.startHealthChecks(ctx)
 			return nil
 		}
 	}
@@ -154,21 +190,72 @@ func (s *EngineClient[_, _]) IsConnected() bool {
 	return s.connected
 }
 
+// IsHealthy returns true if the active execution client passed its last
+// health check and its circuit breaker is closed.
+func (s *EngineClient[_, _]) IsHealthy() bool {
+	active := s.active.Load()
+	return s.IsConnected() && active.healthy.Load() &&
+		active.breaker.State() == ethclientrpc.BreakerClosed
+}
+
 func (s *EngineClient[_, _]) HasCapability(capability string) bool {
 	_, ok := s.capabilities[capability]
 	return ok
 }
 
+// ActiveDialURL returns the dial URL of the execution client that currently
+// answers engine calls.
+func (s *EngineClient[_, _]) ActiveDialURL() *url.ConnectionURL {
+	return s.active.Load().url
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// verifyChainID dials the execution client and
-// ensures the chain ID is correct.
+// verifyChainIDAndConnection dials every execution client and ensures their
+// chain ID is correct. It succeeds as soon as one of them is reachable, in
+// which case the preferred reachable one becomes active.
 func (s *EngineClient[
 	_, _,
 ]) verifyChainIDAndConnection(
 	ctx context.Context,
+) error {
+	var errs []error
+	for _, e := range s.endpoints {
+		err := s.verifyEndpoint(ctx, e)
+		e.healthy.Store(err == nil)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// Log the chain ID.
+		s.logger.Info(
+			"Connected to execution client 🔌",
+			"dial_url", e.url.String(),
+			"chain_id", s.eth1ChainID,
+		)
+	}
+	if !s.selectActive() {
+		return errors.Join(errs...)
+	}
+
+	// Exchange capabilities with the execution client.
+	if _, err := s.ExchangeCapabilities(ctx); err != nil {
+		s.logger.Error("failed to exchange capabilities", "err", err)
+		return err
+	}
+	return nil
+}
+
+// verifyEndpoint dials the execution client of e and ensures the chain ID is
+// correct.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) verifyEndpoint(
+	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
 ) error {
 	var (
 		err     error
@@ -177,12 +264,12 @@ func (s *EngineClient[
 
 	defer func() {
 		if err != nil {
-			err = s.Client.Close()
+			err = e.client.Close()
 		}
 	}()
 
 	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	chainID, err = e.client.ChainID(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
@@ -210,21 +297,5 @@ func (s *EngineClient[
 		return err
 	}
 
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Unwrap(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
-	}
 	return nil
 }
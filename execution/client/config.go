@@ -32,6 +32,11 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
+	defaultRPCRetryBackoff         = 100 * time.Millisecond
+	defaultRPCRetryMaxBackoff      = time.Second
+	defaultRPCBreakerThreshold     = 5
+	defaultRPCBreakerCooldown      = 10 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -44,25 +49,68 @@ func DefaultConfig() Config {
 		RPCDialURL:              dialURL,
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
+		RPCNewPayloadTimeout:    defaultRPCTimeout,
+		RPCForkchoiceTimeout:    defaultRPCTimeout,
+		RPCGetPayloadTimeout:    defaultRPCTimeout,
+		RPCRetryBackoff:         defaultRPCRetryBackoff,
+		RPCRetryMaxBackoff:      defaultRPCRetryMaxBackoff,
+		RPCBreakerThreshold:     defaultRPCBreakerThreshold,
+		RPCBreakerCooldown:      defaultRPCBreakerCooldown,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
 }
 
 // Config is the configuration struct for the execution client.
 type Config struct {
-	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	// RPCDialURL is the url of the execution client JSON-RPC endpoint, either
+	// HTTP(S) or IPC (ipc:///path/to/engine.ipc).
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
-	// RPCRetries is the number of retries before shutting down consensus
-	// client.
+	// RPCBackupDialURLs are the urls, HTTP(S) or IPC, of hot-standby
+	// execution clients.
+	// They receive the same NewPayload and ForkchoiceUpdated calls as the
+	// primary and take over, in order, while it is unreachable.
+	RPCBackupDialURLs []*url.ConnectionURL `mapstructure:"rpc-backup-dial-urls"`
+	// RPCRetries is the number of times idempotent calls are retried after
+	// a transient network error.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
-	// RPCTimeout is the RPC timeout for execution client calls.
+	// RPCRetryBackoff is the backoff before the first retry, doubled on
+	// every retry and jittered.
+	RPCRetryBackoff time.Duration `mapstructure:"rpc-retry-backoff"`
+	// RPCRetryMaxBackoff caps the backoff between retries.
+	RPCRetryMaxBackoff time.Duration `mapstructure:"rpc-retry-max-backoff"`
+	// RPCTimeout is the RPC timeout for execution client calls without a
+	// dedicated timeout.
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
+	// RPCNewPayloadTimeout is the deadline of engine_newPayload calls,
+	// retries included.
+	RPCNewPayloadTimeout time.Duration `mapstructure:"rpc-new-payload-timeout"`
+	// RPCForkchoiceTimeout is the deadline of engine_forkchoiceUpdated calls.
+	RPCForkchoiceTimeout time.Duration `mapstructure:"rpc-forkchoice-updated-timeout"`
+	// RPCGetPayloadTimeout is the deadline of engine_getPayload calls,
+	// retries included.
+	RPCGetPayloadTimeout time.Duration `mapstructure:"rpc-get-payload-timeout"`
+	// RPCBreakerThreshold is the number of consecutive network errors after
+	// which calls to an execution client fail fast.
+	RPCBreakerThreshold int `mapstructure:"rpc-circuit-breaker-threshold"`
+	// RPCBreakerCooldown is how long calls fail fast before the execution
+	// client is probed again.
+	RPCBreakerCooldown time.Duration `mapstructure:"rpc-circuit-breaker-cooldown"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the Interval at which every execution client
+	// is health checked when backups are configured.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
+
+// DialURLs returns the primary dial URL followed by the backup dial URLs,
+// in order of preference.
+func (c Config) DialURLs() []*url.ConnectionURL {
+	return append([]*url.ConnectionURL{c.RPCDialURL}, c.RPCBackupDialURLs...)
+}
@@ -28,6 +28,7 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
@@ -43,18 +44,28 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(
+			ctx, s.cfg.RPCNewPayloadTimeout,
+		)
 	)
 	defer s.metrics.measureNewPayloadDuration(startTime)
 	defer cancel()
 
-	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
+	// Call the appropriate RPC method based on the payload version, on
+	// every execution client.
+	result, err := mirror(cctx, s, s.cfg.RPCNewPayloadTimeout, func(
+		ctx context.Context, c *ethclient.Client[ExecutionPayloadT],
+	) (*engineprimitives.PayloadStatusV1, error) {
+		return c.NewPayload(
+			ctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -83,8 +94,10 @@ func (s *EngineClient[
 /* -------------------------------------------------------------------------- */
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// The payload ID, if any, is only valid on the execution client that is active
+// when this returns.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
@@ -93,7 +106,9 @@ func (s *EngineClient[
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(
+			ctx, s.cfg.RPCForkchoiceTimeout,
+		)
 	)
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
 	defer cancel()
@@ -107,9 +122,11 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
-	)
+	result, err := mirror(cctx, s, s.cfg.RPCForkchoiceTimeout, func(
+		ctx context.Context, c *ethclient.Client[ExecutionPayloadT],
+	) (*engineprimitives.ForkchoiceResponseV1, error) {
+		return c.ForkchoiceUpdated(ctx, state, attrs, forkVersion)
+	})
 
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -133,7 +150,8 @@ func (s *EngineClient[
 /* -------------------------------------------------------------------------- */
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC. It returns
-// the execution data as well as the blobs bundle.
+// the execution data as well as the blobs bundle. It is only sent to the
+// active execution client, which built the payload.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) GetPayload(
@@ -143,13 +161,17 @@ func (s *EngineClient[
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(
+			ctx, s.cfg.RPCGetPayloadTimeout,
+		)
 	)
 	defer s.metrics.measureGetPayloadDuration(startTime)
 	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	result, err := s.active.Load().client.GetPayload(
+		cctx, payloadID, forkVersion,
+	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
@@ -173,7 +195,7 @@ func (s *EngineClient[
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Client.ExchangeCapabilities(
+	result, err := s.active.Load().client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -198,3 +220,13 @@ func (s *EngineClient[
 
 	return result, nil
 }
+
+// GetClientVersionV1 calls the engine_getClientVersionV1 method of the active
+// execution client via JSON-RPC.
+func (s *EngineClient[
+	_, _,
+]) GetClientVersionV1(
+	ctx context.Context,
+) ([]engineprimitives.ClientVersionV1, error) {
+	return s.active.Load().client.GetClientVersionV1(ctx)
+}
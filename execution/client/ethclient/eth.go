@@ -38,7 +38,7 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	ctx context.Context,
 ) (math.U64, error) {
 	var result math.U64
-	if err := ec.Call(ctx, &result, "eth_chainId"); err != nil {
+	if err := ec.Call(ctx, &result, ChainIDMethod); err != nil {
 		return 0, err
 	}
 	return result, nil
@@ -56,7 +56,7 @@ func (ec *Client[ExecutionPayloadT]) FilterLogs(
 	if err != nil {
 		return nil, err
 	}
-	return result, ec.Call(ctx, &result, "eth_getLogs", arg)
+	return result, ec.Call(ctx, &result, GetLogsMethod, arg)
 }
 
 // SubscribeFilterLogs(ctx context.Context, q FilterQuery, ch chan<- types.Log)
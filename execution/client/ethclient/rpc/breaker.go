@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the endpoint while its circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState uint8
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects every call until the cooldown has elapsed.
+	BreakerOpen
+	// BreakerHalfOpen lets a single probe call through, which closes the
+	// breaker on success and opens it again on failure.
+	BreakerHalfOpen
+)
+
+// String returns the name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker is a circuit breaker that stops calling an endpoint after a number
+// of consecutive transport failures, so that callers fail fast instead of
+// waiting for their deadline.
+type Breaker struct {
+	// threshold is the number of consecutive failures that opens the
+	// breaker.
+	threshold int
+	// cooldown is how long the breaker stays open before a probe call is
+	// let through.
+	cooldown time.Duration
+	// onChange is called on every state change, outside of the lock.
+	onChange func(BreakerState)
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// state is the current state.
+	state BreakerState
+	// failures is the number of consecutive failures.
+	failures int
+	// openedAt is the time the breaker last opened.
+	openedAt time.Time
+	// probing is set while the probe call of a half-open breaker is in
+	// flight.
+	probing bool
+}
+
+// NewBreaker creates a new closed circuit breaker. onChange may be nil.
+func NewBreaker(
+	threshold int,
+	cooldown time.Duration,
+	onChange func(BreakerState),
+) *Breaker {
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		onChange:  onChange,
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow returns ErrCircuitOpen if the call must not be made.
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			b.mu.Unlock()
+			return ErrCircuitOpen
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		b.mu.Unlock()
+		b.notify(BreakerHalfOpen)
+		return nil
+	case BreakerHalfOpen:
+		defer b.mu.Unlock()
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		b.mu.Unlock()
+		return nil
+	}
+}
+
+// record updates the breaker with the outcome of a call that was allowed.
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	prev := b.state
+	b.probing = false
+	switch {
+	case !isTransportError(err):
+		b.failures = 0
+		b.state = BreakerClosed
+	case errors.Is(err, context.Canceled):
+		// The caller gave up, this says nothing about the endpoint.
+	default:
+		b.failures++
+		if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+			b.openedAt = time.Now()
+			b.state = BreakerOpen
+		}
+	}
+	state := b.state
+	b.mu.Unlock()
+
+	if state != prev {
+		b.notify(state)
+	}
+}
+
+// notify calls onChange, if set.
+func (b *Breaker) notify(state BreakerState) {
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
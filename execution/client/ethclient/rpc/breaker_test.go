@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first failures requests with a non JSON-RPC answer,
+// then answers with the method name, or with a JSON-RPC error if rpcErr is
+// set.
+func flakyServer(
+	t *testing.T, failures int32, rpcErr bool,
+) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) <= failures {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			var req rpc.Request
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+			if rpcErr {
+				resp["error"] = rpc.Error{Code: -32000, Message: "boom"}
+			} else {
+				resp["result"] = req.Method
+			}
+			//nolint:errcheck // test server.
+			json.NewEncoder(w).Encode(resp)
+		},
+	))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func testRetryPolicy() *rpc.RetryPolicy {
+	return &rpc.RetryPolicy{
+		MaxRetries:  3,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		Methods:     []string{"eth_chainId"},
+	}
+}
+
+func TestClient_RetriesIdempotentCalls(t *testing.T) {
+	server, calls := flakyServer(t, 2, false)
+	client := rpc.NewClient(server.URL, rpc.WithRetryPolicy(testRetryPolicy()))
+
+	var result string
+	require.NoError(t, client.Call(context.Background(), &result, "eth_chainId"))
+	require.Equal(t, "eth_chainId", result)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_DoesNotRetryOtherCalls(t *testing.T) {
+	server, calls := flakyServer(t, 1, false)
+	client := rpc.NewClient(server.URL, rpc.WithRetryPolicy(testRetryPolicy()))
+
+	require.Error(t, client.Call(
+		context.Background(), nil, "engine_forkchoiceUpdatedV3",
+	))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_DoesNotRetryRPCErrors(t *testing.T) {
+	server, calls := flakyServer(t, 0, true)
+	client := rpc.NewClient(server.URL, rpc.WithRetryPolicy(testRetryPolicy()))
+
+	err := client.Call(context.Background(), nil, "eth_chainId")
+	var rpcErr rpc.Error
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_Breaker(t *testing.T) {
+	server, calls := flakyServer(t, 2, false)
+
+	var (
+		mu     sync.Mutex
+		states []rpc.BreakerState
+	)
+	breaker := rpc.NewBreaker(2, 50*time.Millisecond,
+		func(state rpc.BreakerState) {
+			mu.Lock()
+			defer mu.Unlock()
+			states = append(states, state)
+		},
+	)
+	client := rpc.NewClient(server.URL, rpc.WithBreaker(breaker))
+	ctx := context.Background()
+
+	// Two consecutive failures open the breaker, after which calls fail
+	// fast without reaching the server.
+	require.Error(t, client.Call(ctx, nil, "eth_chainId"))
+	require.Error(t, client.Call(ctx, nil, "eth_chainId"))
+	require.Equal(t, rpc.BreakerOpen, breaker.State())
+	require.ErrorIs(t, client.Call(ctx, nil, "eth_chainId"), rpc.ErrCircuitOpen)
+	require.Equal(t, int32(2), calls.Load())
+
+	// Once the cooldown elapsed, a successful probe closes it.
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, client.Call(ctx, nil, "eth_chainId"))
+	require.Equal(t, rpc.BreakerClosed, breaker.State())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []rpc.BreakerState{
+		rpc.BreakerOpen, rpc.BreakerHalfOpen, rpc.BreakerClosed,
+	}, states)
+}
@@ -23,22 +23,33 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Client is an Ethereum RPC client that provides a
-// convenient way to interact with an Ethereum node.
+// convenient way to interact with an Ethereum node. Requests are sent over
+// HTTP, or over a Unix domain socket if the URL has the "ipc" scheme.
 type Client struct {
 	// url is the URL of the RPC endpoint.
 	url string
 	// client is the HTTP client used to make RPC calls.
 	client *http.Client
+	// ipc is the transport used instead of HTTP for IPC endpoints.
+	ipc *ipcTransport
+	// retryPolicy is the retry policy of idempotent calls, if any.
+	retryPolicy *RetryPolicy
+	// breaker is the circuit breaker guarding the endpoint, if any.
+	breaker *Breaker
 	// reqPool is a sync.Pool for reusing RPC request objects.
 	reqPool *sync.Pool
 	// jwtSecret is the JWT secret used for authentication.
@@ -74,11 +85,20 @@ func NewClient(url string, options ...func(rpc *Client)) *Client {
 		option(rpc)
 	}
 
+	if path, ok := ipcPath(url); ok {
+		rpc.ipc = newIPCTransport(path)
+	}
+
 	return rpc
 }
 
-// Start starts the rpc client.
+// Start starts the rpc client. IPC endpoints are not authenticated, so this
+// returns immediately for them.
 func (rpc *Client) Start(ctx context.Context) {
+	if rpc.ipc != nil {
+		return
+	}
+
 	ticker := time.NewTicker(rpc.jwtRefreshInterval)
 	defer ticker.Stop()
 
@@ -100,6 +120,9 @@ func (rpc *Client) Start(ctx context.Context) {
 
 // Close closes the RPC client.
 func (rpc *Client) Close() error {
+	if rpc.ipc != nil {
+		return rpc.ipc.close()
+	}
 	rpc.client.CloseIdleConnections()
 	return nil
 }
@@ -123,7 +146,14 @@ func (rpc *Client) Call(
 // Call returns raw response of method call.
 func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
-) (json.RawMessage, error) {
+) (result json.RawMessage, err error) {
+	ctx, span := tracing.StartSpan(
+		ctx, method,
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("rpc.method", method),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// Pull a request from the pool, we know that it already has the correct
 	// JSONRPC version and ID set.
 	//nolint:errcheck // this is safe.
@@ -139,6 +169,70 @@ func (rpc *Client) CallRaw(
 		return nil, err
 	}
 
+	// Transient transport errors of idempotent calls are retried.
+	for retry := 0; ; retry++ {
+		result, err = rpc.callGuarded(ctx, body)
+		if !isTransportError(err) || errors.Is(err, ErrCircuitOpen) ||
+			retry >= rpc.retryPolicy.retries(method) {
+			return result, err
+		}
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("rpc.retry", retry+1),
+			attribute.String("rpc.error", err.Error()),
+		))
+		if werr := rpc.retryPolicy.wait(ctx, retry); werr != nil {
+			return nil, err
+		}
+	}
+}
+
+// callGuarded sends body through the circuit breaker, if any.
+func (rpc *Client) callGuarded(
+	ctx context.Context, body []byte,
+) (json.RawMessage, error) {
+	if rpc.breaker == nil {
+		return rpc.call(ctx, body)
+	}
+	if err := rpc.breaker.allow(); err != nil {
+		return nil, err
+	}
+	result, err := rpc.call(ctx, body)
+	rpc.breaker.record(err)
+	return result, err
+}
+
+// call sends body over the transport of the endpoint and decodes the
+// response.
+func (rpc *Client) call(
+	ctx context.Context, body []byte,
+) (json.RawMessage, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if rpc.ipc != nil {
+		data, err = rpc.ipc.roundTrip(ctx, body)
+	} else {
+		data, err = rpc.doHTTP(ctx, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(Response)
+	if err = json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Error != nil {
+		return nil, *resp.Error
+	}
+
+	return resp.Result, nil
+}
+
+// doHTTP posts body to the HTTP endpoint and returns the response body.
+func (rpc *Client) doHTTP(ctx context.Context, body []byte) ([]byte, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
@@ -162,19 +256,5 @@ func (rpc *Client) CallRaw(
 	}
 	defer response.Body.Close()
 
-	data, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp := new(Response)
-	if err = json.Unmarshal(data, resp); err != nil {
-		return nil, err
-	}
-
-	if resp.Error != nil {
-		return nil, *resp.Error
-	}
-
-	return resp.Result, nil
+	return io.ReadAll(response.Body)
 }
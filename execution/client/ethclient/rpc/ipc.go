@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	// ipcScheme is the URL scheme selecting the IPC transport, e.g.
+	// ipc:///var/run/geth/geth.ipc.
+	ipcScheme = "ipc"
+	// maxIdleIPCConns is the number of idle connections kept open to the
+	// IPC endpoint.
+	maxIdleIPCConns = 8
+)
+
+// ipcPath returns the socket path of rawURL if it uses the IPC scheme.
+func ipcPath(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != ipcScheme {
+		return "", false
+	}
+	return u.Host + u.Path, true
+}
+
+// ipcTransport sends JSON-RPC requests over a Unix domain socket. Each
+// connection carries one request at a time and is reused afterwards.
+type ipcTransport struct {
+	// path is the path of the Unix domain socket.
+	path string
+	// dialer dials new connections to the socket.
+	dialer net.Dialer
+
+	// mu protects idle for concurrent access.
+	mu sync.Mutex
+	// idle are the connections available for the next requests.
+	idle []*ipcConn
+}
+
+// ipcConn is a connection to the IPC endpoint, along with the decoder that
+// buffers its responses.
+type ipcConn struct {
+	net.Conn
+	dec *json.Decoder
+}
+
+// newIPCTransport creates a new IPC transport for the socket at path.
+func newIPCTransport(path string) *ipcTransport {
+	return &ipcTransport{path: path}
+}
+
+// roundTrip writes body to the socket and reads back a single JSON value.
+func (t *ipcTransport) roundTrip(
+	ctx context.Context,
+	body []byte,
+) (json.RawMessage, error) {
+	conn, err := t.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unblock the read and write once the context is done.
+	stop := context.AfterFunc(ctx, func() {
+		//#nosec:G104 // the connection is discarded afterwards.
+		_ = conn.SetDeadline(time.Unix(1, 0))
+	})
+
+	var data json.RawMessage
+	if _, err = conn.Write(body); err == nil {
+		err = conn.dec.Decode(&data)
+	}
+	if !stop() {
+		err = context.Cause(ctx)
+	}
+	if err != nil {
+		//#nosec:G104 // the connection is broken already.
+		_ = conn.Close()
+		return nil, err
+	}
+
+	t.put(conn)
+	return data, nil
+}
+
+// get returns an idle connection, dialing a new one if none is available.
+func (t *ipcTransport) get(ctx context.Context) (*ipcConn, error) {
+	t.mu.Lock()
+	if n := len(t.idle); n > 0 {
+		conn := t.idle[n-1]
+		t.idle = t.idle[:n-1]
+		t.mu.Unlock()
+		return conn, nil
+	}
+	t.mu.Unlock()
+
+	conn, err := t.dialer.DialContext(ctx, "unix", t.path)
+	if err != nil {
+		return nil, err
+	}
+	return &ipcConn{Conn: conn, dec: json.NewDecoder(conn)}, nil
+}
+
+// put makes conn available to the next requests, or closes it if enough
+// connections are idle already.
+func (t *ipcTransport) put(conn *ipcConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.idle) >= maxIdleIPCConns {
+		//#nosec:G104 // nothing to do on error.
+		_ = conn.Close()
+		return
+	}
+	t.idle = append(t.idle, conn)
+}
+
+// close closes every idle connection.
+func (t *ipcTransport) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var err error
+	for _, conn := range t.idle {
+		if cerr := conn.Close(); cerr != nil {
+			err = cerr
+		}
+	}
+	t.idle = nil
+	return err
+}
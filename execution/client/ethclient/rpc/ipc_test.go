@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// serveIPC answers every request on the socket at path with the method
+// name as the result, until the test ends.
+func serveIPC(t *testing.T, path string, delay time.Duration) {
+	t.Helper()
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				dec := json.NewDecoder(bufio.NewReader(conn))
+				enc := json.NewEncoder(conn)
+				for {
+					var req rpc.Request
+					if err := dec.Decode(&req); err != nil {
+						return
+					}
+					time.Sleep(delay)
+					if err := enc.Encode(map[string]any{
+						"jsonrpc": "2.0", "id": req.ID, "result": req.Method,
+					}); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+}
+
+func TestClient_IPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.ipc")
+	serveIPC(t, path, 0)
+
+	client := rpc.NewClient("ipc://" + path)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result string
+			require.NoError(t, client.Call(
+				context.Background(), &result, "eth_chainId",
+			))
+			require.Equal(t, "eth_chainId", result)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClient_IPCTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.ipc")
+	serveIPC(t, path, time.Second)
+
+	client := rpc.NewClient("ipc://" + path)
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+
+	var result string
+	err := client.Call(ctx, &result, "eth_chainId")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_IPCUnavailable(t *testing.T) {
+	client := rpc.NewClient(
+		"ipc://" + filepath.Join(t.TempDir(), "missing.ipc"),
+	)
+	var result string
+	require.Error(t, client.Call(context.Background(), &result, "eth_chainId"))
+}
@@ -39,3 +39,17 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// WithRetryPolicy sets the retry policy of idempotent calls.
+func WithRetryPolicy(policy *RetryPolicy) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.retryPolicy = policy
+	}
+}
+
+// WithBreaker sets the circuit breaker guarding the endpoint.
+func WithBreaker(breaker *Breaker) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.breaker = breaker
+	}
+}
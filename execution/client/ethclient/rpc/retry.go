@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy configures the retries of idempotent calls that failed with a
+// transient transport error. Calls that got a JSON-RPC error back are never
+// retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// BaseBackoff is the backoff before the first retry. It doubles on
+	// every retry, up to MaxBackoff, and is jittered by up to 50%.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the backoff between retries.
+	MaxBackoff time.Duration
+	// Methods are the idempotent methods that may be retried.
+	Methods []string
+}
+
+// retries returns the number of retries allowed for method.
+func (p *RetryPolicy) retries(method string) int {
+	if p == nil {
+		return 0
+	}
+	for _, m := range p.Methods {
+		if m == method {
+			return p.MaxRetries
+		}
+	}
+	return 0
+}
+
+// backoff returns the jittered delay before the given retry, starting at 0.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseBackoff << retry
+	if delay > p.MaxBackoff || delay <= 0 {
+		delay = p.MaxBackoff
+	}
+	//#nosec:G404 // jitter does not need a secure source.
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// wait sleeps for the backoff of the given retry, or until ctx is done.
+func (p *RetryPolicy) wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.backoff(retry))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isTransportError returns true if err means the endpoint could not be
+// called or did not answer, as opposed to answering with a JSON-RPC error.
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr Error
+	return !errors.As(err, &rpcErr)
+}
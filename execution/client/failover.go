@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+)
+
+// errEndpointUnhealthy is returned for calls that were not sent to an
+// endpoint because it failed its last health check.
+var errEndpointUnhealthy = errors.New("execution client is unhealthy")
+
+// endpoint is one of the execution clients the engine client talks to.
+type endpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	// url is the dial URL of the execution client.
+	url *url.ConnectionURL
+	// client is the JSON-RPC client of the execution client.
+	client *ethclient.Client[ExecutionPayloadT]
+	// breaker is the circuit breaker guarding the execution client.
+	breaker *ethclientrpc.Breaker
+	// healthy is set while the execution client is reachable and on the
+	// expected chain.
+	healthy atomic.Bool
+}
+
+// selectActive makes the preferred healthy endpoint active. It returns false,
+// leaving the active endpoint untouched, if none is healthy.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) selectActive() bool {
+	for _, e := range s.endpoints {
+		if e.healthy.Load() {
+			s.setActive(e)
+			return true
+		}
+	}
+	return false
+}
+
+// setActive makes e the endpoint that answers engine calls.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) setActive(e *endpoint[ExecutionPayloadT]) {
+	prev := s.active.Swap(e)
+	if prev == e {
+		return
+	}
+	s.logger.Warn(
+		"Switched active execution client 🔀",
+		"from", prev.url.String(),
+		"to", e.url.String(),
+	)
+	s.metrics.incrementFailover()
+}
+
+// startHealthChecks periodically health checks every endpoint and switches
+// back to the preferred one once it recovers. Without backups it still
+// restores the health of the sole endpoint after a transient error.
+func (s *EngineClient[_, _]) startHealthChecks(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.checkHealth(ctx)
+			}
+		}
+	}()
+}
+
+// checkHealth health checks every endpoint and selects the active one.
+func (s *EngineClient[_, _]) checkHealth(ctx context.Context) {
+	var numHealthy int
+	for _, e := range s.endpoints {
+		cctx, cancel := s.createContextWithTimeout(ctx, s.cfg.RPCTimeout)
+		err := s.verifyEndpoint(cctx, e)
+		cancel()
+
+		if healthy := err == nil; e.healthy.Swap(healthy) != healthy {
+			if healthy {
+				s.logger.Info(
+					"Execution client is healthy again ✅",
+					"dial_url", e.url.String(),
+				)
+			} else {
+				s.logger.Error(
+					"Execution client failed its health check",
+					"dial_url", e.url.String(),
+					"err", err,
+				)
+			}
+		}
+		if e.healthy.Load() {
+			numHealthy++
+		}
+	}
+	s.metrics.setHealthyEndpoints(numHealthy)
+	s.selectActive()
+}
+
+// onBreakerStateChange returns the callback logging and reporting the state
+// changes of the circuit breaker of the i-th endpoint.
+func (s *EngineClient[_, _]) onBreakerStateChange(
+	i int, dialURL *url.ConnectionURL,
+) func(ethclientrpc.BreakerState) {
+	endpoint := strconv.Itoa(i)
+	return func(state ethclientrpc.BreakerState) {
+		if state == ethclientrpc.BreakerOpen {
+			s.logger.Error(
+				"Execution client circuit breaker opened, failing fast 🚧",
+				"dial_url", dialURL.String(),
+				"cooldown", s.cfg.RPCBreakerCooldown,
+			)
+		} else {
+			s.logger.Info(
+				"Execution client circuit breaker state changed",
+				"dial_url", dialURL.String(),
+				"state", state.String(),
+			)
+		}
+		s.metrics.setBreakerState(endpoint, state)
+	}
+}
+
+// mirror sends call to the active endpoint and, in the background, to every
+// healthy backup so they stay in sync with it. The answer of the active
+// endpoint is returned unless it cannot be reached, in which case the first
+// backup, in order of preference, that answered becomes active and its answer
+// is returned instead.
+func mirror[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT PayloadAttributes,
+	ResultT any,
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	timeout time.Duration,
+	call func(
+		context.Context, *ethclient.Client[ExecutionPayloadT],
+	) (ResultT, error),
+) (ResultT, error) {
+	type answer struct {
+		result ResultT
+		err    error
+	}
+
+	active := s.active.Load()
+	answers := make([]chan answer, len(s.endpoints))
+	for i, e := range s.endpoints {
+		answers[i] = make(chan answer, 1)
+		switch {
+		case e == active:
+			go func() {
+				result, err := call(ctx, e.client)
+				answers[i] <- answer{result, err}
+			}()
+		case e.healthy.Load():
+			// Backups must not be cancelled when the active endpoint
+			// answers, they only share the deadline.
+			go func() {
+				cctx, cancel := s.createContextWithTimeout(
+					context.WithoutCancel(ctx), timeout,
+				)
+				defer cancel()
+				result, err := call(cctx, e.client)
+				answers[i] <- answer{result, err}
+			}()
+		default:
+			answers[i] <- answer{err: errEndpointUnhealthy}
+		}
+	}
+
+	var activeAnswer answer
+	for i, e := range s.endpoints {
+		if e == active {
+			activeAnswer = <-answers[i]
+			break
+		}
+	}
+	if !isUnreachable(ctx, activeAnswer.err) {
+		return activeAnswer.result, activeAnswer.err
+	}
+
+	active.healthy.Store(false)
+	for i, e := range s.endpoints {
+		if e == active {
+			continue
+		}
+		if a := <-answers[i]; !isUnreachable(ctx, a.err) {
+			s.setActive(e)
+			return a.result, a.err
+		}
+	}
+	return activeAnswer.result, activeAnswer.err
+}
+
+// isUnreachable returns true if err means the execution client could not
+// answer, as opposed to answering with an error.
+func isUnreachable(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	// The caller gave up, the execution client is not at fault.
+	if ctx.Err() != nil &&
+		!errors.Is(context.Cause(ctx), engineerrors.ErrEngineAPITimeout) {
+		return false
+	}
+	var rpcErr ethclientrpc.Error
+	return !errors.As(err, &rpcErr)
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+// fakeEL is a minimal execution client that answers the calls made by the
+// engine client.
+type fakeEL struct {
+	*httptest.Server
+	// down makes the execution client answer every request with a 503.
+	down            atomic.Bool
+	newPayloadCalls atomic.Int32
+}
+
+func newFakeEL(t *testing.T) *fakeEL {
+	t.Helper()
+	el := &fakeEL{}
+	el.Server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if el.down.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			var req struct {
+				ID     int    `json:"id"`
+				Method string `json:"method"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			var result any
+			switch req.Method {
+			case "eth_chainId":
+				result = hexutil.EncodeUint64(testChainID)
+			case "engine_exchangeCapabilities":
+				result = []string{}
+			case "engine_newPayloadV3":
+				el.newPayloadCalls.Add(1)
+				result = engineprimitives.PayloadStatusV1{
+					Status:          engineprimitives.PayloadStatusValid,
+					LatestValidHash: &common.ExecutionHash{0x1},
+				}
+			}
+			//nolint:errcheck // test server.
+			json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0", "id": req.ID, "result": result,
+			})
+		},
+	))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func newTestEngineClient(
+	t *testing.T,
+	primary string,
+	backups ...string,
+) *client.EngineClient[
+	*types.ExecutionPayload,
+	*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+] {
+	t.Helper()
+	cfg := client.DefaultConfig()
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	cfg.RPCHealthCheckInterval = 10 * time.Millisecond
+	cfg.RPCRetryBackoff = time.Millisecond
+	cfg.RPCRetryMaxBackoff = time.Millisecond
+	cfg.RPCBreakerCooldown = 50 * time.Millisecond
+
+	var err error
+	cfg.RPCDialURL, err = url.NewFromRaw(primary)
+	require.NoError(t, err)
+	for _, backup := range backups {
+		u, err := url.NewFromRaw(backup)
+		require.NoError(t, err)
+		cfg.RPCBackupDialURLs = append(cfg.RPCBackupDialURLs, u)
+	}
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ec := client.New[
+		*types.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+	](
+		&cfg, noop.NewLogger[any](), secret, noopSink{},
+		big.NewInt(testChainID),
+	)
+	require.NoError(t, ec.Start(ctx))
+	return ec
+}
+
+func TestEngineClient_StartsOnBackup(t *testing.T) {
+	primary := newFakeEL(t)
+	primary.Close()
+	backup := newFakeEL(t)
+
+	ec := newTestEngineClient(t, primary.URL, backup.URL)
+	require.Equal(t, backup.URL, ec.ActiveDialURL().String())
+	require.True(t, ec.IsHealthy())
+}
+
+func TestEngineClient_NewPayloadFailover(t *testing.T) {
+	primary := newFakeEL(t)
+	backup := newFakeEL(t)
+	ec := newTestEngineClient(t, primary.URL, backup.URL)
+	require.Equal(t, primary.URL, ec.ActiveDialURL().String())
+
+	// Backups receive every NewPayload call.
+	_, err := ec.NewPayload(
+		context.Background(), &types.ExecutionPayload{}, nil, &common.Root{},
+		nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primary.newPayloadCalls.Load())
+	require.Eventually(t, func() bool {
+		return backup.newPayloadCalls.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// The backup takes over when the primary goes away.
+	primary.Close()
+	_, err = ec.NewPayload(
+		context.Background(), &types.ExecutionPayload{}, nil, &common.Root{},
+		nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, backup.URL, ec.ActiveDialURL().String())
+}
+
+func TestEngineClient_SwitchesBackToPrimary(t *testing.T) {
+	primary := newFakeEL(t)
+	backup := newFakeEL(t)
+	ec := newTestEngineClient(t, primary.URL, backup.URL)
+
+	// Health checks move away from the primary while it is down and back to
+	// it once it recovers.
+	primary.down.Store(true)
+	require.Eventually(t, func() bool {
+		return ec.ActiveDialURL().String() == backup.URL
+	}, time.Second, 10*time.Millisecond)
+
+	primary.down.Store(false)
+	require.Eventually(t, func() bool {
+		return ec.ActiveDialURL().String() == primary.URL
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEngineClient_SingleEndpointRecovers(t *testing.T) {
+	el := newFakeEL(t)
+	ec := newTestEngineClient(t, el.URL)
+
+	// A transient error marks the sole execution client unhealthy until a
+	// health check succeeds again.
+	el.down.Store(true)
+	_, err := ec.NewPayload(
+		context.Background(), &types.ExecutionPayload{}, nil, &common.Root{},
+		nil, version.Deneb,
+	)
+	require.Error(t, err)
+	require.False(t, ec.IsHealthy())
+
+	el.down.Store(false)
+	require.Eventually(t, ec.IsHealthy, time.Second, 10*time.Millisecond)
+}
+
+// noopSink is a TelemetrySink that discards every metric.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) SetGauge(string, int64, ...string)         {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
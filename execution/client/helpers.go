@@ -29,17 +29,18 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
-// createContextWithTimeout creates a context with a timeout and returns it
-// along with the cancel function.
+// createContextWithTimeout creates a context with the given timeout and
+// returns it along with the cancel function.
 func (s *EngineClient[
 	_, _,
 ]) createContextWithTimeout(
 	ctx context.Context,
+	timeout time.Duration,
 ) (context.Context, context.CancelFunc) {
 	startTime := time.Now()
 	dctx, cancel := context.WithTimeoutCause(
 		ctx,
-		s.cfg.RPCTimeout,
+		timeout,
 		engineerrors.ErrEngineAPITimeout,
 	)
 	s.metrics.measureNewPayloadDuration(startTime)
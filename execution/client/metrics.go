@@ -23,6 +23,7 @@ package client
 import (
 	"time"
 
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/log"
 )
 
@@ -94,6 +95,38 @@ func (cm *clientMetrics) incrementGetPayloadTimeout() {
 		"beacon_kit.execution.client.get_payload_duration")
 }
 
+// incrementFailover increments the counter of switches of the active
+// execution client.
+func (cm *clientMetrics) incrementFailover() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
+// setHealthyEndpoints sets the number of execution clients that passed their
+// last health check.
+func (cm *clientMetrics) setHealthyEndpoints(count int) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.healthy_endpoints", int64(count),
+	)
+}
+
+// setBreakerState sets the state of the circuit breaker of the given endpoint,
+// 0 for closed, 1 for open and 2 for half-open, and counts openings.
+func (cm *clientMetrics) setBreakerState(
+	endpoint string, state ethclientrpc.BreakerState,
+) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.circuit_breaker_state",
+		int64(state),
+		"endpoint", endpoint,
+	)
+	if state == ethclientrpc.BreakerOpen {
+		cm.sink.IncrementCounter(
+			"beacon_kit.execution.client.circuit_breaker_opened",
+			"endpoint", endpoint,
+		)
+	}
+}
+
 // incrementHTTPTimeout increments the timeout counter for HTTP.
 func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
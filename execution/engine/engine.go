@@ -210,6 +210,8 @@ func (ee *Engine[
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
+		req.ForkVersion,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gethprimitives_test
+
+import (
+	"math/big"
+	"testing"
+
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcRequestsHash(t *testing.T) {
+	// With no requests the commitment is the hash of the empty string, as
+	// expected by Prague execution clients.
+	empty := common.HexToHash(
+		"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	)
+	require.Equal(t, empty, gethprimitives.CalcRequestsHash([][]byte{}))
+
+	// Requests that carry only their type are skipped.
+	require.Equal(t, empty, gethprimitives.CalcRequestsHash([][]byte{{0x01}}))
+	require.NotEqual(t, empty, gethprimitives.CalcRequestsHash(
+		[][]byte{{0x01, 0xff}},
+	))
+}
+
+func TestPragueHeaderHash(t *testing.T) {
+	header := &gethprimitives.Header{
+		UncleHash:  gethprimitives.EmptyUncleHash,
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(1),
+		BaseFee:    big.NewInt(7),
+	}
+
+	// The Prague hash commits to the requests hash on top of the header.
+	h1, err := gethprimitives.PragueHeaderHash(header, common.Hash{0x01})
+	require.NoError(t, err)
+	h2, err := gethprimitives.PragueHeaderHash(header, common.Hash{0x02})
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h2)
+	require.NotEqual(t, header.Hash(), h1)
+}
@@ -34,8 +34,8 @@ type Backend[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
-	BeaconBlockBodyT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BeaconStateT BeaconState[
 		ExecutionPayloadHeaderT, ForkT,
 		ValidatorT, ValidatorsT, WithdrawalT,
@@ -62,7 +62,7 @@ type Backend[
 	cs   common.ChainSpec
 	node NodeT
 
-	sp StateProcessor[BeaconStateT]
+	sp StateProcessor[BeaconBlockT, BeaconStateT]
 }
 
 // New creates and returns a new Backend instance.
@@ -70,8 +70,8 @@ func New[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
-	BeaconBlockBodyT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BeaconStateT BeaconState[
 		ExecutionPayloadHeaderT, ForkT,
 		ValidatorT, ValidatorsT, WithdrawalT,
@@ -96,7 +96,7 @@ func New[
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
-	sp StateProcessor[BeaconStateT],
+	sp StateProcessor[BeaconBlockT, BeaconStateT],
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
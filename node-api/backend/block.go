@@ -22,6 +22,7 @@ package backend
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -57,6 +58,22 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
+// BlobSidecarsAtSlot returns the blob sidecars of the block at the given
+// slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(slot math.Slot) (*datypes.BlobSidecars, error) {
+	if slot == 0 {
+		// Resolve the head slot the same way the state lookups do.
+		_, resolved, err := b.stateFromSlotRaw(slot)
+		if err != nil {
+			return nil, err
+		}
+		slot = resolved
+	}
+	return b.sb.AvailabilityStore().GetBlobSidecars(slot)
+}
+
 // TODO: Implement this.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
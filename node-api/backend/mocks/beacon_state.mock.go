@@ -27,17 +27,17 @@ func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, W
 	return &BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{mock: &_m.Mock}
 }
 
-// EVMInflationWithdrawal provides a mock function with given fields:
-func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) EVMInflationWithdrawal() WithdrawalT {
-	ret := _m.Called()
+// EVMInflationWithdrawal provides a mock function with given fields: slot
+func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) EVMInflationWithdrawal(slot math.U64) WithdrawalT {
+	ret := _m.Called(slot)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EVMInflationWithdrawal")
 	}
 
 	var r0 WithdrawalT
-	if rf, ok := ret.Get(0).(func() WithdrawalT); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(math.U64) WithdrawalT); ok {
+		r0 = rf(slot)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(WithdrawalT)
@@ -53,13 +53,14 @@ type BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT any, ForkT
 }
 
 // EVMInflationWithdrawal is a helper method to define mock.On call
-func (_e *BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) EVMInflationWithdrawal() *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
-	return &BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("EVMInflationWithdrawal")}
+//   - slot math.U64
+func (_e *BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) EVMInflationWithdrawal(slot interface{}) *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("EVMInflationWithdrawal", slot)}
 }
 
-func (_c *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+func (_c *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func(slot math.U64)) *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(math.U64))
 	})
 	return _c
 }
@@ -69,7 +70,7 @@ func (_c *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT
 	return _c
 }
 
-func (_c *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() WithdrawalT) *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+func (_c *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func(math.U64) WithdrawalT) *BeaconState_EVMInflationWithdrawal_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -245,6 +246,61 @@ func (_c *BeaconState_GetBlockRootAtIndex_Call[ExecutionPayloadHeaderT, ForkT, V
 	return _c
 }
 
+// GetDepositRequestsStartIndex provides a mock function with given fields:
+func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetDepositRequestsStartIndex() (uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositRequestsStartIndex")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconState_GetDepositRequestsStartIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositRequestsStartIndex'
+type BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// GetDepositRequestsStartIndex is a helper method to define mock.On call
+func (_e *BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetDepositRequestsStartIndex() *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("GetDepositRequestsStartIndex")}
+}
+
+func (_c *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 uint64, _a1 error) *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() (uint64, error)) *BeaconState_GetDepositRequestsStartIndex_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEth1Data provides a mock function with given fields:
 func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetEth1Data() (*types.Eth1Data, error) {
 	ret := _m.Called()
@@ -695,6 +751,63 @@ func (_c *BeaconState_GetNextWithdrawalValidatorIndex_Call[ExecutionPayloadHeade
 	return _c
 }
 
+// GetPendingDeposits provides a mock function with given fields:
+func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetPendingDeposits() ([]*types.Deposit, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingDeposits")
+	}
+
+	var r0 []*types.Deposit
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*types.Deposit, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*types.Deposit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Deposit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconState_GetPendingDeposits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingDeposits'
+type BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// GetPendingDeposits is a helper method to define mock.On call
+func (_e *BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetPendingDeposits() *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("GetPendingDeposits")}
+}
+
+func (_c *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 []*types.Deposit, _a1 error) *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() ([]*types.Deposit, error)) *BeaconState_GetPendingDeposits_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandaoMixAtIndex provides a mock function with given fields: _a0
 func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetRandaoMixAtIndex(_a0 uint64) (bytes.B32, error) {
 	ret := _m.Called(_a0)
@@ -1144,6 +1257,53 @@ func (_c *BeaconState_GetValidatorsByEffectiveBalance_Call[ExecutionPayloadHeade
 	return _c
 }
 
+// HashTreeRoot provides a mock function with given fields:
+func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) HashTreeRoot() common.Root {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashTreeRoot")
+	}
+
+	var r0 common.Root
+	if rf, ok := ret.Get(0).(func() common.Root); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Root)
+		}
+	}
+
+	return r0
+}
+
+// BeaconState_HashTreeRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashTreeRoot'
+type BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// HashTreeRoot is a helper method to define mock.On call
+func (_e *BeaconState_Expecter[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) HashTreeRoot() *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("HashTreeRoot")}
+}
+
+func (_c *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 common.Root) *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() common.Root) *BeaconState_HashTreeRoot_Call[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetSlot provides a mock function with given fields: _a0
 func (_m *BeaconState[ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) SetSlot(_a0 math.U64) error {
 	ret := _m.Called(_a0)
@@ -10,20 +10,20 @@ import (
 )
 
 // StateProcessor is an autogenerated mock type for the StateProcessor type
-type StateProcessor[BeaconStateT any] struct {
+type StateProcessor[BeaconBlockT any, BeaconStateT any] struct {
 	mock.Mock
 }
 
-type StateProcessor_Expecter[BeaconStateT any] struct {
+type StateProcessor_Expecter[BeaconBlockT any, BeaconStateT any] struct {
 	mock *mock.Mock
 }
 
-func (_m *StateProcessor[BeaconStateT]) EXPECT() *StateProcessor_Expecter[BeaconStateT] {
-	return &StateProcessor_Expecter[BeaconStateT]{mock: &_m.Mock}
+func (_m *StateProcessor[BeaconBlockT, BeaconStateT]) EXPECT() *StateProcessor_Expecter[BeaconBlockT, BeaconStateT] {
+	return &StateProcessor_Expecter[BeaconBlockT, BeaconStateT]{mock: &_m.Mock}
 }
 
 // ProcessSlots provides a mock function with given fields: _a0, _a1
-func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
+func (_m *StateProcessor[BeaconBlockT, BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
@@ -53,41 +53,101 @@ func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.
 }
 
 // StateProcessor_ProcessSlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessSlots'
-type StateProcessor_ProcessSlots_Call[BeaconStateT any] struct {
+type StateProcessor_ProcessSlots_Call[BeaconBlockT any, BeaconStateT any] struct {
 	*mock.Call
 }
 
 // ProcessSlots is a helper method to define mock.On call
 //   - _a0 BeaconStateT
 //   - _a1 math.U64
-func (_e *StateProcessor_Expecter[BeaconStateT]) ProcessSlots(_a0 interface{}, _a1 interface{}) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
-	return &StateProcessor_ProcessSlots_Call[BeaconStateT]{Call: _e.mock.On("ProcessSlots", _a0, _a1)}
+func (_e *StateProcessor_Expecter[BeaconBlockT, BeaconStateT]) ProcessSlots(_a0 interface{}, _a1 interface{}) *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT] {
+	return &StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT]{Call: _e.mock.On("ProcessSlots", _a0, _a1)}
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Run(run func(_a0 BeaconStateT, _a1 math.U64)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT]) Run(run func(_a0 BeaconStateT, _a1 math.U64)) *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT] {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(BeaconStateT), args[1].(math.U64))
 	})
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT] {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) RunAndReturn(run func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT]) RunAndReturn(run func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)) *StateProcessor_ProcessSlots_Call[BeaconBlockT, BeaconStateT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transition provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StateProcessor[BeaconBlockT, BeaconStateT]) Transition(_a0 *transition.Context, _a1 BeaconStateT, _a2 BeaconBlockT) (transition.ValidatorUpdates, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 transition.ValidatorUpdates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*transition.Context, BeaconStateT, BeaconBlockT) (transition.ValidatorUpdates, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*transition.Context, BeaconStateT, BeaconBlockT) transition.ValidatorUpdates); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transition.ValidatorUpdates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*transition.Context, BeaconStateT, BeaconBlockT) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateProcessor_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type StateProcessor_Transition_Call[BeaconBlockT any, BeaconStateT any] struct {
+	*mock.Call
+}
+
+// Transition is a helper method to define mock.On call
+//   - _a0 *transition.Context
+//   - _a1 BeaconStateT
+//   - _a2 BeaconBlockT
+func (_e *StateProcessor_Expecter[BeaconBlockT, BeaconStateT]) Transition(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT] {
+	return &StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT]{Call: _e.mock.On("Transition", _a0, _a1, _a2)}
+}
+
+func (_c *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT]) Run(run func(_a0 *transition.Context, _a1 BeaconStateT, _a2 BeaconBlockT)) *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*transition.Context), args[1].(BeaconStateT), args[2].(BeaconBlockT))
+	})
+	return _c
+}
+
+func (_c *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT]) RunAndReturn(run func(*transition.Context, BeaconStateT, BeaconBlockT) (transition.ValidatorUpdates, error)) *StateProcessor_Transition_Call[BeaconBlockT, BeaconStateT] {
 	_c.Call.Return(run)
 	return _c
 }
 
 // NewStateProcessor creates a new instance of StateProcessor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewStateProcessor[BeaconStateT any](t interface {
+func NewStateProcessor[BeaconBlockT any, BeaconStateT any](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *StateProcessor[BeaconStateT] {
-	mock := &StateProcessor[BeaconStateT]{}
+}) *StateProcessor[BeaconBlockT, BeaconStateT] {
+	mock := &StateProcessor[BeaconBlockT, BeaconStateT]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	simulatetypes "github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+// SimulateBlock runs the state transition of the block on top of the latest
+// state, as the proposer with the given CometBFT address. The state is read
+// from a query context, so the transition is never committed. A block failing
+// the transition is reported in the simulation rather than as an error.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalT, _,
+]) SimulateBlock(
+	ctx context.Context,
+	blk BeaconBlockT,
+	proposerAddress []byte,
+	skipPayloadVerification bool,
+) (*simulatetypes.BlockSimulation[WithdrawalT], error) {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &simulatetypes.BlockSimulation[WithdrawalT]{
+		ValidatorUpdates: make([]*simulatetypes.ValidatorUpdate, 0),
+		Withdrawals:      make([]WithdrawalT, 0),
+	}
+	validatorUpdates, err := b.sp.Transition(
+		&transition.Context{
+			Context:                 ctx,
+			SkipPayloadVerification: skipPayloadVerification,
+			SkipValidateResult:      true,
+			ProposerAddress:         proposerAddress,
+			//#nosec:G701 // the current time is positive.
+			ConsensusTime: math.U64(time.Now().Unix()),
+		},
+		st, blk,
+	)
+	if err != nil {
+		simulation.Error = err.Error()
+		return simulation, nil
+	}
+
+	simulation.StateRoot = st.HashTreeRoot()
+	// The transition verified the withdrawals of the payload against the
+	// state, so those are the withdrawals processed by the block.
+	var withdrawal WithdrawalT
+	for _, w := range blk.GetBody().GetExecutionPayload().GetWithdrawals() {
+		simulation.Withdrawals = append(
+			simulation.Withdrawals,
+			withdrawal.New(w.Index, w.Validator, w.Address, w.Amount),
+		)
+	}
+	for _, update := range validatorUpdates {
+		simulation.ValidatorUpdates = append(
+			simulation.ValidatorUpdates,
+			&simulatetypes.ValidatorUpdate{
+				Pubkey:           update.Pubkey,
+				EffectiveBalance: update.EffectiveBalance.Unwrap(),
+			},
+		)
+	}
+	return simulation, nil
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	simulatetypes "github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testBeaconState = mocks.BeaconState[
+		*ctypes.ExecutionPayloadHeader, *ctypes.Fork,
+		*ctypes.Validator, ctypes.Validators, *engineprimitives.Withdrawal,
+	]
+	testStorageBackend = mocks.StorageBackend[
+		*testAvailabilityStore, *testBeaconState,
+		*mocks.BlockStore[*ctypes.BeaconBlock],
+		*mocks.DepositStore[*ctypes.Deposit],
+	]
+	testAvailabilityStore = mocks.AvailabilityStore[
+		*ctypes.BeaconBlockBody, *datypes.BlobSidecars,
+	]
+	testStateProcessor = mocks.StateProcessor[
+		*ctypes.BeaconBlock, *testBeaconState,
+	]
+)
+
+// queryContextKey marks the query context the state is read from.
+type queryContextKey struct{}
+
+// simulateTestSuite holds the backend under test and the mocks it wraps.
+type simulateTestSuite struct {
+	cs       common.ChainSpec
+	queryCtx context.Context
+	st       *testBeaconState
+	sb       *testStorageBackend
+	sp       *testStateProcessor
+	b        *backend.Backend[
+		*testAvailabilityStore, *ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+		*testBeaconState, any, *datypes.BlobSidecars,
+		*mocks.BlockStore[*ctypes.BeaconBlock], context.Context,
+		*ctypes.Deposit, *mocks.DepositStore[*ctypes.Deposit],
+		*ctypes.ExecutionPayloadHeader, *ctypes.Fork,
+		*mocks.Node[context.Context], any, *testStorageBackend,
+		*ctypes.Validator, ctypes.Validators, *engineprimitives.Withdrawal,
+		ctypes.WithdrawalCredentials,
+	]
+}
+
+func newSimulateTestSuite(t *testing.T) *simulateTestSuite {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	s := &simulateTestSuite{
+		cs: cs,
+		queryCtx: context.WithValue(
+			context.Background(), queryContextKey{}, true,
+		),
+		st: mocks.NewBeaconState[
+			*ctypes.ExecutionPayloadHeader, *ctypes.Fork,
+			*ctypes.Validator, ctypes.Validators, *engineprimitives.Withdrawal,
+		](t),
+		sb: mocks.NewStorageBackend[
+			*testAvailabilityStore, *testBeaconState,
+			*mocks.BlockStore[*ctypes.BeaconBlock],
+			*mocks.DepositStore[*ctypes.Deposit],
+		](t),
+		sp: mocks.NewStateProcessor[*ctypes.BeaconBlock, *testBeaconState](t),
+	}
+	s.b = backend.New[
+		*testAvailabilityStore, *ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+		*testBeaconState, any, *datypes.BlobSidecars,
+		*mocks.BlockStore[*ctypes.BeaconBlock], context.Context,
+		*ctypes.Deposit, *mocks.DepositStore[*ctypes.Deposit],
+		*ctypes.ExecutionPayloadHeader, *ctypes.Fork,
+		*mocks.Node[context.Context], any, *testStorageBackend,
+		*ctypes.Validator, ctypes.Validators, *engineprimitives.Withdrawal,
+		ctypes.WithdrawalCredentials,
+	](s.sb, cs, s.sp)
+
+	// The simulation reads the latest state from a query context, which is
+	// never committed.
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().CreateQueryContext(int64(0), false).
+		Return(s.queryCtx, nil).Once()
+	s.b.AttachQueryBackend(node)
+	s.sb.EXPECT().StateFromContext(s.queryCtx).Return(s.st).Once()
+	s.st.EXPECT().GetSlot().Return(0, nil).Once()
+	return s
+}
+
+// testBlock returns a block for slot with a payload carrying withdrawals.
+func (s *simulateTestSuite) testBlock(
+	t *testing.T, slot math.Slot,
+) *ctypes.BeaconBlock {
+	t.Helper()
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		slot, 1, common.Root{1, 2, 3},
+		s.cs.ActiveForkVersionForSlot(slot),
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = &ctypes.Eth1Data{}
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:        math.U64(slot),
+		BaseFeePerGas: math.NewU256(7),
+		Withdrawals: engineprimitives.Withdrawals{
+			{
+				Index:     3,
+				Validator: 1,
+				Address:   common.ExecutionAddress{0xaa},
+				Amount:    100,
+			},
+			{
+				Index:     4,
+				Validator: 2,
+				Address:   common.ExecutionAddress{0xbb},
+				Amount:    200,
+			},
+		},
+	}
+	return blk
+}
+
+// expectTransition expects a single transition of a block with the root of
+// blk, applied to the state read from the query context.
+func (s *simulateTestSuite) expectTransition(
+	t *testing.T,
+	blk *ctypes.BeaconBlock,
+	updates transition.ValidatorUpdates,
+	err error,
+) {
+	t.Helper()
+	s.sp.EXPECT().Transition(mock.Anything, s.st, mock.Anything).
+		Run(func(
+			ctx *transition.Context, _ *testBeaconState,
+			decoded *ctypes.BeaconBlock,
+		) {
+			require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+			require.True(t, ctx.SkipValidateResult)
+			require.True(t, ctx.SkipPayloadVerification)
+			require.Equal(t, []byte{0x01}, ctx.ProposerAddress)
+		}).
+		Return(updates, err).Once()
+}
+
+func TestSimulateBlockDecodesJSON(t *testing.T) {
+	s := newSimulateTestSuite(t)
+	blk := s.testBlock(t, 1)
+	encoded, err := json.Marshal(blk)
+	require.NoError(t, err)
+
+	decoded, err := simulatetypes.SimulateBlockRequest{Block: encoded}.
+		DecodeBlock(s.cs)
+	require.NoError(t, err)
+
+	s.expectTransition(t, blk, nil, nil)
+	s.st.EXPECT().HashTreeRoot().Return(common.Root{0x0f}).Once()
+	simulation, err := s.b.SimulateBlock(
+		context.Background(), decoded, []byte{0x01}, true,
+	)
+	require.NoError(t, err)
+	require.Empty(t, simulation.Error)
+	require.Equal(t, common.Root{0x0f}, simulation.StateRoot)
+	require.Equal(t,
+		[]*engineprimitives.Withdrawal(
+			blk.GetBody().GetExecutionPayload().GetWithdrawals(),
+		),
+		simulation.Withdrawals,
+	)
+}
+
+func TestSimulateBlockDecodesSSZ(t *testing.T) {
+	s := newSimulateTestSuite(t)
+	blk := s.testBlock(t, 1)
+	encoded, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := simulatetypes.SimulateBlockRequest{SSZ: encoded}.
+		DecodeBlock(s.cs)
+	require.NoError(t, err)
+
+	pubkey := crypto.BLSPubkey{0x02}
+	s.expectTransition(t, blk, transition.ValidatorUpdates{
+		{Pubkey: pubkey, EffectiveBalance: 32e9},
+	}, nil)
+	s.st.EXPECT().HashTreeRoot().Return(common.Root{0x0f}).Once()
+	simulation, err := s.b.SimulateBlock(
+		context.Background(), decoded, []byte{0x01}, true,
+	)
+	require.NoError(t, err)
+	require.Empty(t, simulation.Error)
+	require.Equal(t, []*simulatetypes.ValidatorUpdate{
+		{Pubkey: pubkey, EffectiveBalance: 32e9},
+	}, simulation.ValidatorUpdates)
+	require.Len(t, simulation.Withdrawals, 2)
+}
+
+func TestSimulateBlockRequiresBlock(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, err = simulatetypes.SimulateBlockRequest{}.DecodeBlock(cs)
+	require.ErrorIs(t, err, simulatetypes.ErrNoBlock)
+}
+
+func TestSimulateBlockReportsFailedTransition(t *testing.T) {
+	s := newSimulateTestSuite(t)
+	blk := s.testBlock(t, 1)
+
+	errTransition := errors.New("invalid withdrawals")
+	s.expectTransition(t, blk, nil, errTransition)
+	simulation, err := s.b.SimulateBlock(
+		context.Background(), blk, []byte{0x01}, true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, errTransition.Error(), simulation.Error)
+	require.Equal(t, common.Root{}, simulation.StateRoot)
+	require.Empty(t, simulation.Withdrawals)
+	require.Empty(t, simulation.ValidatorUpdates)
+}
+
+func TestSimulateBlockDoesNotCommitState(t *testing.T) {
+	s := newSimulateTestSuite(t)
+	blk := s.testBlock(t, 1)
+
+	s.expectTransition(t, blk, nil, nil)
+	s.st.EXPECT().HashTreeRoot().Return(common.Root{0x0f}).Once()
+	_, err := s.b.SimulateBlock(
+		context.Background(), blk, []byte{0x01}, true,
+	)
+	require.NoError(t, err)
+
+	// The state is only read from the query context: neither the storage
+	// backend nor the state are written to.
+	s.sb.AssertNumberOfCalls(t, "StateFromContext", 1)
+	s.sb.AssertNotCalled(t, "AvailabilityStore")
+	s.sb.AssertNotCalled(t, "BlockStore")
+	s.sb.AssertNotCalled(t, "DepositStore")
+	s.st.AssertNotCalled(t, "SetSlot", mock.Anything)
+}
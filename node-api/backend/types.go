@@ -23,6 +23,8 @@ package backend
 import (
 	"context"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -41,6 +43,21 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the sidecars stored for the slot, reading them
+	// from the archive once pruned.
+	GetBlobSidecars(math.Slot) (*datypes.BlobSidecars, error)
+}
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is the interface for a beacon block body.
+type BeaconBlockBody interface {
+	// GetExecutionPayload returns the execution payload of the block.
+	GetExecutionPayload() *ctypes.ExecutionPayload
 }
 
 // BeaconState is the interface for the beacon state.
@@ -50,6 +67,8 @@ type BeaconState[
 ] interface {
 	// SetSlot sets the slot on the beacon state.
 	SetSlot(math.Slot) error
+	// HashTreeRoot returns the root of the beacon state.
+	HashTreeRoot() common.Root
 
 	core.ReadOnlyBeaconState[
 		ExecutionPayloadHeaderT,
@@ -84,8 +103,11 @@ type Node[ContextT any] interface {
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
 }
 
-type StateProcessor[BeaconStateT any] interface {
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+	Transition(
+		*transition.Context, BeaconStateT, BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"slices"
+
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+func (h *Handler[
+	ContextT, _, _,
+]) GetBlobSidecars(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSidecarsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]uint64, 0, len(req.Indices))
+	for _, idx := range req.Indices {
+		var index math.U64
+		if index, err = utils.U64FromString(idx); err != nil {
+			return nil, err
+		}
+		indices = append(indices, index.Unwrap())
+	}
+	sidecars, err := h.backend.BlobSidecarsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*beacontypes.BlobSidecarData, 0, len(sidecars.Sidecars))
+	for _, sidecar := range sidecars.Sidecars {
+		if len(indices) > 0 && !slices.Contains(indices, sidecar.Index) {
+			continue
+		}
+		data = append(data, &beacontypes.BlobSidecarData{
+			Index:         sidecar.Index,
+			Blob:          sidecar.Blob,
+			KZGCommitment: sidecar.KzgCommitment,
+			KZGProof:      sidecar.KzgProof,
+			SignedBlockHeader: &beacontypes.UnsignedBlockHeader{
+				Message: sidecar.BeaconBlockHeader,
+			},
+			KZGCommitmentInclusionProof: sidecar.InclusionProof,
+		})
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                data,
+	}, nil
+}
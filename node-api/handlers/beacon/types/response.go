@@ -24,6 +24,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -48,6 +49,22 @@ type BlockHeader struct {
 	Signature bytes.B48                 `json:"signature"`
 }
 
+// BlobSidecarData is a blob sidecar as served by the beacon API. Beacon
+// blocks are not signed on their own, CometBFT signs the votes on them, so the
+// block header of the sidecar comes without a signature.
+type BlobSidecarData struct {
+	Index                       uint64                `json:"index,string"`
+	Blob                        eip4844.Blob          `json:"blob"`
+	KZGCommitment               eip4844.KZGCommitment `json:"kzg_commitment"`
+	KZGProof                    eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader           *UnsignedBlockHeader  `json:"signed_block_header"`
+	KZGCommitmentInclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
+}
+
+type UnsignedBlockHeader struct {
+	Message *ctypes.BeaconBlockHeader `json:"message"`
+}
+
 type GenesisData struct {
 	GenesisTime           string      `json:"genesis_time"`
 	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
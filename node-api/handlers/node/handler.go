@@ -25,15 +25,25 @@ import (
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
+// ExecutionClient reports the health of the execution client.
+type ExecutionClient interface {
+	// IsHealthy returns true if the execution client can be called.
+	IsHealthy() bool
+}
+
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	executionClient ExecutionClient
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	executionClient ExecutionClient,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		executionClient: executionClient,
 	}
 	return h
 }
@@ -44,9 +44,13 @@ func ProveProposerPubkeyInBlock[
 	],
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the proposer pubkey in the beacon state.
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
 	proposerOffset := ValidatorPubkeyGIndexOffset * bbh.GetProposerIndex()
 	valPubkeyInStateProof, leaf, err := ProveProposerPubkeyInState(
-		bs, proposerOffset,
+		bsm, proposerOffset,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -63,7 +67,7 @@ func ProveProposerPubkeyInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyProposerInBlock(
-		bbh, proposerOffset, combinedProof, leaf,
+		bbh, bsm.Version(), proposerOffset, combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -74,27 +78,18 @@ func ProveProposerPubkeyInBlock[
 
 // ProveProposerPubkeyInState generates a proof for the proposer pubkey
 // in the beacon state. It uses the fastssz library to generate the proof.
-func ProveProposerPubkeyInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+func ProveProposerPubkeyInState(
+	bsm types.BeaconStateMarshallable,
 	proposerOffset math.U64,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
+	gIndex := gIndicesForVersion(bsm.Version()).zeroValidatorPubkeyState +
+		int(proposerOffset)
 	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -113,13 +108,15 @@ func ProveProposerPubkeyInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyProposerInBlock(
 	bbh *ctypes.BeaconBlockHeader,
+	forkVersion uint32,
 	valOffset math.U64,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		merkle.GeneralizedIndex(ZeroValidatorPubkeyGIndexDenebBlock+valOffset),
+		gIndicesForVersion(forkVersion).zeroValidatorPubkeyBlock+
+			merkle.GeneralizedIndex(valOffset),
 		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
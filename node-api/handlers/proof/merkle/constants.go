@@ -20,6 +20,11 @@
 
 package merkle
 
+import (
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
 const (
 	// ProposerIndexGIndexDenebBlock is the generalized index of the proposer
 	// index in the beacon block in the Deneb fork.
@@ -68,4 +73,71 @@ const (
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
 	ExecutionFeeRecipientGIndexDenebBlock = 5889
+
+	// ZeroValidatorPubkeyGIndexElectraState is the generalized index of the 0
+	// validator's pubkey in the beacon state in the Electra fork, whose state
+	// has grown past 16 fields.
+	ZeroValidatorPubkeyGIndexElectraState = 721279627821056
+
+	// ZeroValidatorPubkeyGIndexElectraBlock is the generalized index of the 0
+	// validator's pubkey in the beacon block in the Electra fork. This is
+	// calculated by concatenating the (ZeroValidatorPubkeyGIndexElectraState,
+	// StateGIndexDenebBlock) GIndices.
+	ZeroValidatorPubkeyGIndexElectraBlock = 6350779162034176
+
+	// ExecutionNumberGIndexElectraState is the generalized index of the latest
+	// execution payload header in the beacon state in the Electra fork.
+	ExecutionNumberGIndexElectraState = 1286
+
+	// ExecutionNumberGIndexElectraBlock is the generalized index of the number
+	// in the latest execution payload header in the beacon block in the
+	// Electra fork. This is calculated by concatenating the
+	// (ExecutionNumberGIndexElectraState, StateGIndexDenebBlock) GIndices.
+	ExecutionNumberGIndexElectraBlock = 11526
+
+	// ExecutionFeeRecipientGIndexElectraState is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon state
+	// in the Electra fork.
+	ExecutionFeeRecipientGIndexElectraState = 1281
+
+	// ExecutionFeeRecipientGIndexElectraBlock is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon block
+	// in the Electra fork. This is calculated by concatenating the
+	// (ExecutionFeeRecipientGIndexElectraState, StateGIndexDenebBlock)
+	// GIndices.
+	ExecutionFeeRecipientGIndexElectraBlock = 11521
 )
+
+// stateGIndices are the generalized indices of the proven beacon state fields,
+// which move when a fork grows the beacon state.
+type stateGIndices struct {
+	zeroValidatorPubkeyState int
+	zeroValidatorPubkeyBlock merkle.GeneralizedIndex
+	executionNumberState     int
+	executionNumberBlock     merkle.GeneralizedIndex
+	feeRecipientState        int
+	feeRecipientBlock        merkle.GeneralizedIndex
+}
+
+// gIndicesForVersion returns the generalized indices of the beacon state
+// fields for the given fork version.
+func gIndicesForVersion(forkVersion uint32) stateGIndices {
+	if forkVersion >= version.Electra {
+		return stateGIndices{
+			zeroValidatorPubkeyState: ZeroValidatorPubkeyGIndexElectraState,
+			zeroValidatorPubkeyBlock: ZeroValidatorPubkeyGIndexElectraBlock,
+			executionNumberState:     ExecutionNumberGIndexElectraState,
+			executionNumberBlock:     ExecutionNumberGIndexElectraBlock,
+			feeRecipientState:        ExecutionFeeRecipientGIndexElectraState,
+			feeRecipientBlock:        ExecutionFeeRecipientGIndexElectraBlock,
+		}
+	}
+	return stateGIndices{
+		zeroValidatorPubkeyState: ZeroValidatorPubkeyGIndexDenebState,
+		zeroValidatorPubkeyBlock: ZeroValidatorPubkeyGIndexDenebBlock,
+		executionNumberState:     ExecutionNumberGIndexDenebState,
+		executionNumberBlock:     ExecutionNumberGIndexDenebBlock,
+		feeRecipientState:        ExecutionFeeRecipientGIndexDenebState,
+		feeRecipientBlock:        ExecutionFeeRecipientGIndexDenebBlock,
+	}
+}
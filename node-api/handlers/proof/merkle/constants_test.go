@@ -25,15 +25,16 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	mlib "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
 	"github.com/stretchr/testify/require"
 )
 
 var (
-	// beaconStateSchema is the schema for the BeaconState struct defined in
-	// beacon-kit/mod/consensus-types/types/state.go.
-	beaconStateSchema = schema.DefineContainer(
+	// beaconStateFields are the fields of the BeaconState struct defined in
+	// beacon-kit/mod/consensus-types/types/state.go in the Deneb fork.
+	beaconStateFields = []*schema.Field[schema.SSZType]{
 		schema.NewField("GenesisValidatorsRoot", schema.B32()),
 		schema.NewField("Slot", schema.U64()),
 		schema.NewField("Fork", schema.DefineContainer(
@@ -95,7 +96,27 @@ var (
 			"Slashings", schema.DefineList(schema.U64(), types.MaxValidators),
 		),
 		schema.NewField("TotalSlashing", schema.U64()),
-	)
+	}
+
+	// beaconStateSchema is the schema for the BeaconState in the Deneb fork.
+	beaconStateSchema = schema.DefineContainer(beaconStateFields...)
+
+	// beaconStateSchemaElectra is the schema for the BeaconState in the
+	// Electra fork, which appends the deposit requests start index and the
+	// pending deposits.
+	beaconStateSchemaElectra = schema.DefineContainer(append(
+		append([]*schema.Field[schema.SSZType]{}, beaconStateFields...),
+		schema.NewField("DepositRequestsStartIndex", schema.U64()),
+		schema.NewField("PendingDeposits", schema.DefineList(
+			schema.DefineContainer(
+				schema.NewField("Pubkey", schema.B48()),
+				schema.NewField("Credentials", schema.B32()),
+				schema.NewField("Amount", schema.U64()),
+				schema.NewField("Signature", schema.B96()),
+				schema.NewField("Index", schema.U64()),
+			), constants.PendingDepositsLimit,
+		)),
+	)...)
 
 	// beaconHeaderSchema is the schema for the BeaconBlockHeader struct defined
 	// in beacon-kit/mod/consensus-types/types/header.go, with the SSZ
@@ -107,6 +128,16 @@ var (
 		schema.NewField("State", beaconStateSchema),
 		schema.NewField("BodyRoot", schema.B32()),
 	)
+
+	// beaconHeaderSchemaElectra is the beaconHeaderSchema with the SSZ
+	// expansion of StateRoot to use the Electra BeaconState.
+	beaconHeaderSchemaElectra = schema.DefineContainer(
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("ProposerIndex", schema.U64()),
+		schema.NewField("ParentRoot", schema.B32()),
+		schema.NewField("State", beaconStateSchemaElectra),
+		schema.NewField("BodyRoot", schema.B32()),
+	)
 )
 
 // TestGIndexProposerIndexDeneb tests the generalized index of the proposer
@@ -246,3 +277,49 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 		concatExecutionFeeRecipientStateToBlock,
 	)
 }
+
+// TestGIndicesElectra tests the generalized indices of the beacon state fields
+// that moved when the Electra fork grew the beacon state.
+func TestGIndicesElectra(t *testing.T) {
+	testCases := []struct {
+		path      string
+		stateGIdx int
+		blockGIdx int
+	}{
+		{
+			path:      "Validators/0/Pubkey",
+			stateGIdx: merkle.ZeroValidatorPubkeyGIndexElectraState,
+			blockGIdx: merkle.ZeroValidatorPubkeyGIndexElectraBlock,
+		},
+		{
+			path:      "LatestExecutionPayloadHeader/Number",
+			stateGIdx: merkle.ExecutionNumberGIndexElectraState,
+			blockGIdx: merkle.ExecutionNumberGIndexElectraBlock,
+		},
+		{
+			path:      "LatestExecutionPayloadHeader/FeeRecipient",
+			stateGIdx: merkle.ExecutionFeeRecipientGIndexElectraState,
+			blockGIdx: merkle.ExecutionFeeRecipientGIndexElectraBlock,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, stateGIndex, _, err := mlib.ObjectPath[
+				mlib.GeneralizedIndex, [32]byte,
+			](tc.path).GetGeneralizedIndex(beaconStateSchemaElectra)
+			require.NoError(t, err)
+			require.Equal(t, tc.stateGIdx, int(stateGIndex))
+
+			_, blockGIndex, _, err := mlib.ObjectPath[
+				mlib.GeneralizedIndex, [32]byte,
+			]("State/" + tc.path).GetGeneralizedIndex(beaconHeaderSchemaElectra)
+			require.NoError(t, err)
+			require.Equal(t, tc.blockGIdx, int(blockGIndex))
+
+			// Concatenation is consistent.
+			require.Equal(t, blockGIndex, mlib.GeneralizedIndices{
+				merkle.StateGIndexDenebBlock, stateGIndex,
+			}.Concat())
+		})
+	}
+}
@@ -45,7 +45,11 @@ func ProveExecutionNumberInBlock[
 	],
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the execution number in the beacon state.
-	numberInStateProof, leaf, err := ProveExecutionNumberInState(bs)
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	numberInStateProof, leaf, err := ProveExecutionNumberInState(bsm)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -60,7 +64,9 @@ func ProveExecutionNumberInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(numberInStateProof, stateInBlockProof...)
-	beaconRoot, err := verifyExecutionNumberInBlock(bbh, combinedProof, leaf)
+	beaconRoot, err := verifyExecutionNumberInBlock(
+		bbh, bsm.Version(), combinedProof, leaf,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -70,26 +76,16 @@ func ProveExecutionNumberInBlock[
 
 // ProveExecutionNumberInState generates a proof for the block number of the
 // execution payload in the beacon state. It uses the fastssz library.
-func ProveExecutionNumberInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+func ProveExecutionNumberInState(
+	bsm types.BeaconStateMarshallable,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	numberInStateProof, err := stateProofTree.Prove(
-		ExecutionNumberGIndexDenebState,
+		gIndicesForVersion(bsm.Version()).executionNumberState,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -108,12 +104,14 @@ func ProveExecutionNumberInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionNumberInBlock(
 	bbh *ctypes.BeaconBlockHeader,
+	forkVersion uint32,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionNumberGIndexDenebBlock, leaf, proof, beaconRoot,
+		gIndicesForVersion(forkVersion).executionNumberBlock,
+		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
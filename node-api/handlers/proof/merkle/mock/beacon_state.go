@@ -95,6 +95,8 @@ func NewBeaconState(
 		0,
 		[]math.Gwei{},
 		0,
+		0,
+		nil,
 	)
 	return &BeaconState{BeaconStateMarshallable: bsm}, err
 }
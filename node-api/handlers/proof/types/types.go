@@ -45,6 +45,8 @@ type BeaconState[
 type BeaconStateMarshallable interface {
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version the state is encoded for.
+	Version() uint32
 }
 
 // ExecutionPayloadHeader is the interface for an execution payload header.
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulate
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	simulatetypes "github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// SimulateBlock runs the state transition for an unsigned beacon block on top
+// of the latest state and returns the resulting post state root, validator
+// updates and withdrawals. Nothing is persisted.
+func (h *Handler[ContextT, _, _]) SimulateBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[simulatetypes.SimulateBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := req.DecodeBlock(h.backend.ChainSpec())
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	// The state processor checks the block against the address of its
+	// proposer, which is derived from the proposer pubkey.
+	proposer, err := h.backend.ValidatorByID(
+		utils.Head,
+		strconv.FormatUint(blk.GetProposerIndex().Unwrap(), 10),
+	)
+	if err != nil {
+		return nil, err
+	}
+	proposerAddress, err := crypto.GetAddressFromPubKey(
+		proposer.Validator.GetPubkey(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Simulating block", "slot", blk.GetSlot())
+	return h.backend.SimulateBlock(
+		c.Request().Context(),
+		blk,
+		proposerAddress,
+		req.SkipsPayloadVerification(),
+	)
+}
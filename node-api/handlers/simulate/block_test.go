@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package simulate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/simulate"
+	simulatetypes "github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// testSimulation is the outcome of a simulation.
+	testSimulation = simulatetypes.BlockSimulation[*engineprimitives.Withdrawal]
+)
+
+// requestContextKey marks the context of the request.
+type requestContextKey struct{}
+
+// testContext is a request context binding its JSON body.
+type testContext struct {
+	req *http.Request
+}
+
+func newTestContext(body string) *testContext {
+	req := httptest.NewRequest(
+		http.MethodPost, "/bkit/v1/simulate/block", strings.NewReader(body),
+	)
+	return &testContext{req: req.WithContext(
+		context.WithValue(req.Context(), requestContextKey{}, true),
+	)}
+}
+
+func (c *testContext) Bind(v any) error {
+	return json.NewDecoder(c.req.Body).Decode(v)
+}
+
+func (*testContext) Validate(any) error { return nil }
+
+func (c *testContext) Request() *http.Request { return c.req }
+
+// testBackend records the simulations requested.
+type testBackend struct {
+	cs        common.ChainSpec
+	proposer  *ctypes.Validator
+	ctx       context.Context
+	blk       *ctypes.BeaconBlock
+	address   []byte
+	skip      bool
+	simulated int
+}
+
+func (b *testBackend) ChainSpec() common.ChainSpec { return b.cs }
+
+func (b *testBackend) ValidatorByID(
+	_ math.Slot, id string,
+) (*beacontypes.ValidatorData[*ctypes.Validator], error) {
+	if id != "1" {
+		return nil, errors.New("unknown validator")
+	}
+	return &beacontypes.ValidatorData[*ctypes.Validator]{
+		Validator: b.proposer,
+	}, nil
+}
+
+func (b *testBackend) SimulateBlock(
+	ctx context.Context,
+	blk *ctypes.BeaconBlock,
+	proposerAddress []byte,
+	skipPayloadVerification bool,
+) (*testSimulation, error) {
+	b.ctx, b.blk = ctx, blk
+	b.address, b.skip = proposerAddress, skipPayloadVerification
+	b.simulated++
+	return &testSimulation{
+		StateRoot: common.Root{0x0f},
+	}, nil
+}
+
+func newTestHandler(t *testing.T) (
+	*simulate.Handler[
+		*testContext, *ctypes.Validator, *engineprimitives.Withdrawal,
+	],
+	*testBackend,
+) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	key, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	b := &testBackend{
+		cs: cs,
+		proposer: &ctypes.Validator{
+			Pubkey: crypto.BLSPubkey(key.PubKey().Bytes()),
+		},
+	}
+	h := simulate.NewHandler[
+		*testContext, *ctypes.Validator, *engineprimitives.Withdrawal,
+	](b)
+	h.RegisterRoutes(noop.NewLogger[any]())
+	return h, b
+}
+
+// testRequest returns the body of a request simulating a block for slot
+// proposed by the validator at index 1.
+func testRequest(
+	t *testing.T, cs common.ChainSpec, slot math.Slot, skip string,
+) (string, *ctypes.BeaconBlock) {
+	t.Helper()
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		slot, 1, common.Root{1, 2, 3}, cs.ActiveForkVersionForSlot(slot),
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = &ctypes.Eth1Data{}
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:        math.U64(slot),
+		BaseFeePerGas: math.NewU256(7),
+	}
+	encoded, err := json.Marshal(blk)
+	require.NoError(t, err)
+	body := `{"block":` + string(encoded)
+	if skip != "" {
+		body += `,"skip_payload_verification":` + skip
+	}
+	return body + "}", blk
+}
+
+func TestSimulateBlock(t *testing.T) {
+	h, b := newTestHandler(t)
+	body, blk := testRequest(t, b.cs, 2, "")
+
+	res, err := h.SimulateBlock(newTestContext(body))
+	require.NoError(t, err)
+	simulation, ok := res.(*testSimulation)
+	require.True(t, ok)
+	require.Equal(t, common.Root{0x0f}, simulation.StateRoot)
+	require.Equal(t, 1, b.simulated)
+	require.Equal(t, blk.HashTreeRoot(), b.blk.HashTreeRoot())
+
+	// The simulation runs within the request and skips verifying the
+	// payload unless asked to.
+	require.Equal(t, true, b.ctx.Value(requestContextKey{}))
+	require.True(t, b.skip)
+	address, err := crypto.GetAddressFromPubKey(b.proposer.GetPubkey())
+	require.NoError(t, err)
+	require.Equal(t, address, b.address)
+}
+
+func TestSimulateBlockVerifiesPayload(t *testing.T) {
+	h, b := newTestHandler(t)
+	body, _ := testRequest(t, b.cs, 2, "false")
+
+	_, err := h.SimulateBlock(newTestContext(body))
+	require.NoError(t, err)
+	require.False(t, b.skip)
+}
+
+func TestSimulateBlockInvalidRequest(t *testing.T) {
+	h, b := newTestHandler(t)
+
+	_, err := h.SimulateBlock(newTestContext(`{"block":`))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = h.SimulateBlock(newTestContext(`{}`))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.Zero(t, b.simulated)
+}
+
+func TestSimulateBlockUnknownProposer(t *testing.T) {
+	h, b := newTestHandler(t)
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		2, 5, common.Root{}, b.cs.ActiveForkVersionForSlot(2),
+	)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(blk)
+	require.NoError(t, err)
+
+	_, err = h.SimulateBlock(
+		newTestContext(`{"block":` + string(encoded) + `}`),
+	)
+	require.Error(t, err)
+	require.Zero(t, b.simulated)
+}
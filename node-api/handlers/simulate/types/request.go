@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrNoBlock is returned when a request carries neither a JSON nor an SSZ
+// encoded block.
+var ErrNoBlock = errors.New("block or ssz must be provided")
+
+// SimulateBlockRequest is the request for the `/simulate/block` endpoint.
+// The block is given either as JSON or as SSZ.
+type SimulateBlockRequest struct {
+	// Block is the JSON encoded beacon block.
+	Block json.RawMessage `json:"block"`
+	// SSZ is the SSZ encoded beacon block, used when Block is not set.
+	SSZ bytes.Bytes `json:"ssz"`
+	// SkipPayloadVerification skips sending the execution payload of the
+	// block to the execution client for verification, which is the default
+	// as the execution client would otherwise import the payload.
+	SkipPayloadVerification *bool `json:"skip_payload_verification"`
+}
+
+// SkipsPayloadVerification returns whether the execution payload of the block
+// is not verified by the execution client, true unless the request sets it.
+func (r SimulateBlockRequest) SkipsPayloadVerification() bool {
+	return r.SkipPayloadVerification == nil || *r.SkipPayloadVerification
+}
+
+// DecodeBlock decodes the beacon block of the request, preferring the JSON
+// encoding over the SSZ one. The block is decoded for the fork active at its
+// slot.
+func (r SimulateBlockRequest) DecodeBlock(
+	cs common.ChainSpec,
+) (*ctypes.BeaconBlock, error) {
+	switch {
+	case len(r.Block) > 0:
+		var header struct {
+			Slot math.Slot `json:"slot"`
+		}
+		if err := json.Unmarshal(r.Block, &header); err != nil {
+			return nil, err
+		}
+		blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+			header.Slot, 0, common.Root{},
+			cs.ActiveForkVersionForSlot(header.Slot),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(r.Block, blk); err != nil {
+			return nil, err
+		}
+		return blk, nil
+	case len(r.SSZ) >= int(constants.U64Size):
+		// The slot is the first field of the block.
+		slot := math.Slot(binary.LittleEndian.Uint64(r.SSZ))
+		return (&ctypes.BeaconBlock{}).NewFromSSZ(
+			r.SSZ, cs.ActiveForkVersionForSlot(slot),
+		)
+	default:
+		return nil, ErrNoBlock
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock returns an empty block for slot of the fork active at slot.
+func testBlock(
+	t *testing.T, cs common.ChainSpec, slot math.Slot,
+) *ctypes.BeaconBlock {
+	t.Helper()
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		slot, 1, common.Root{1, 2, 3}, cs.ActiveForkVersionForSlot(slot),
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = &ctypes.Eth1Data{}
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:        math.U64(slot),
+		BaseFeePerGas: math.NewU256(7),
+	}
+	return blk
+}
+
+func TestDecodeBlock(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	blk := testBlock(t, cs, 3)
+	encodedJSON, err := json.Marshal(blk)
+	require.NoError(t, err)
+	encodedSSZ, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	other, err := testBlock(t, cs, 4).MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     types.SimulateBlockRequest
+		wantErr bool
+	}{
+		{
+			name: "json",
+			req:  types.SimulateBlockRequest{Block: encodedJSON},
+		},
+		{
+			name: "ssz",
+			req:  types.SimulateBlockRequest{SSZ: encodedSSZ},
+		},
+		{
+			name: "json over ssz",
+			req: types.SimulateBlockRequest{
+				Block: encodedJSON, SSZ: other,
+			},
+		},
+		{
+			name:    "invalid json",
+			req:     types.SimulateBlockRequest{Block: []byte(`{"slot":`)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ssz",
+			req:     types.SimulateBlockRequest{SSZ: encodedSSZ[:12]},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, decodeErr := tt.req.DecodeBlock(cs)
+			if tt.wantErr {
+				require.Error(t, decodeErr)
+				return
+			}
+			require.NoError(t, decodeErr)
+			require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+		})
+	}
+}
+
+func TestDecodeBlockRequiresBlock(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, err = types.SimulateBlockRequest{SSZ: []byte{1, 2, 3}}.DecodeBlock(cs)
+	require.ErrorIs(t, err, types.ErrNoBlock)
+}
+
+func TestSkipsPayloadVerification(t *testing.T) {
+	var req types.SimulateBlockRequest
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &req))
+	require.True(t, req.SkipsPayloadVerification())
+
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"skip_payload_verification":false}`), &req,
+	))
+	require.False(t, req.SkipsPayloadVerification())
+
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"skip_payload_verification":true}`), &req,
+	))
+	require.True(t, req.SkipsPayloadVerification())
+}
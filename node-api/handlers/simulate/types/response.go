@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// BlockSimulation is the response for the `/simulate/block` endpoint, the
+// outcome of the state transition of the block on top of the latest state.
+type BlockSimulation[WithdrawalT any] struct {
+	// StateRoot is the root of the post state, zero if the transition
+	// failed.
+	StateRoot common.Root `json:"state_root"`
+	// ValidatorUpdates are the updates to the validator set resulting from
+	// the transition.
+	ValidatorUpdates []*ValidatorUpdate `json:"validator_updates"`
+	// Withdrawals are the withdrawals processed by the block.
+	Withdrawals []WithdrawalT `json:"withdrawals"`
+	// Error is the reason the transition failed, empty if it succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// ValidatorUpdate is an update to the validator set.
+type ValidatorUpdate struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance,string"`
+}
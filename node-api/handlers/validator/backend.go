@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Backend is the interface for backend of the validator API.
+type Backend[ValidatorT any] interface {
+	ValidatorByID(
+		slot math.Slot, id string,
+	) (*beacontypes.ValidatorData[ValidatorT], error)
+}
+
+// Registry is the registry the payload preferences of the validators are
+// persisted to.
+type Registry interface {
+	SetFeeRecipient(
+		pubkey crypto.BLSPubkey, feeRecipient common.ExecutionAddress,
+	) error
+	VerifyRegistration(
+		pubkey crypto.BLSPubkey,
+		feeRecipient common.ExecutionAddress,
+		gasLimit uint64,
+		timestamp uint64,
+	) error
+	Register(
+		pubkey crypto.BLSPubkey,
+		feeRecipient common.ExecutionAddress,
+		gasLimit uint64,
+		timestamp uint64,
+	) error
+}
+
+// ChainSpec is the interface for the chain spec of the validator API.
+type ChainSpec interface {
+	ActiveForkVersionForEpoch(epoch math.Epoch) uint32
+	DomainTypeApplicationMask() common.DomainType
+}
+
+// SignatureVerifier verifies the signatures of the validator registrations.
+type SignatureVerifier interface {
+	VerifySignature(
+		pubKey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+	) error
+}
+
+// Validator is the interface for the validators of the beacon state.
+type Validator interface {
+	GetPubkey() crypto.BLSPubkey
+}
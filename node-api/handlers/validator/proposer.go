@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	vtypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// PrepareBeaconProposer registers the fee recipient of the given validators.
+// The registrations are persisted by the node and used whenever it builds a
+// payload for one of them.
+func (h *Handler[ContextT, _]) PrepareBeaconProposer(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[vtypes.PrepareBeaconProposerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve all the validators before persisting any registration.
+	pubkeys := make([]crypto.BLSPubkey, len(req))
+	for i, preparation := range req {
+		if _, err = utils.U64FromString(preparation.ValidatorIndex); err != nil {
+			return nil, errors.Wrapf(
+				types.ErrInvalidRequest,
+				"invalid validator index %q", preparation.ValidatorIndex,
+			)
+		}
+		validator, vErr := h.backend.ValidatorByID(
+			utils.Head, preparation.ValidatorIndex,
+		)
+		if vErr != nil {
+			return nil, vErr
+		}
+		pubkeys[i] = validator.Validator.GetPubkey()
+	}
+
+	for i, preparation := range req {
+		if err = h.registry.SetFeeRecipient(
+			pubkeys[i], preparation.FeeRecipient,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	vtypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// RegisterValidator registers the fee recipient and the target gas limit of
+// the given validators. As in the builder specification, the registrations
+// must be signed by the validators over the application builder domain.
+func (h *Handler[ContextT, _]) RegisterValidator(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[vtypes.RegisterValidatorRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Registrations are signed over the genesis fork version and an empty
+	// genesis validators root, so they remain valid across forks.
+	forkData := ctypes.NewForkData(
+		version.FromUint32[common.Version](
+			h.chainSpec.ActiveForkVersionForEpoch(
+				math.Epoch(constants.GenesisEpoch),
+			),
+		),
+		common.Root{},
+	)
+
+	// Verify all the registrations before persisting any of them.
+	registrations := make([]*ctypes.ValidatorRegistration, len(req))
+	for i, signed := range req {
+		registration, rErr := toValidatorRegistration(signed.Message)
+		if rErr != nil {
+			return nil, rErr
+		}
+		if _, err = h.backend.ValidatorByID(
+			utils.Head, registration.Pubkey.String(),
+		); err != nil {
+			return nil, err
+		}
+		if err = registration.Verify(
+			forkData,
+			signed.Signature,
+			h.chainSpec.DomainTypeApplicationMask(),
+			h.verifier.VerifySignature,
+		); err != nil {
+			return nil, errors.Wrapf(
+				types.ErrInvalidRequest, "%s: %s", registration.Pubkey, err,
+			)
+		}
+		if err = h.registry.VerifyRegistration(
+			registration.Pubkey,
+			registration.FeeRecipient,
+			registration.GasLimit.Unwrap(),
+			registration.Timestamp.Unwrap(),
+		); err != nil {
+			return nil, errors.Wrapf(
+				types.ErrInvalidRequest, "%s: %s", registration.Pubkey, err,
+			)
+		}
+		registrations[i] = registration
+	}
+
+	for _, registration := range registrations {
+		if err = h.registry.Register(
+			registration.Pubkey,
+			registration.FeeRecipient,
+			registration.GasLimit.Unwrap(),
+			registration.Timestamp.Unwrap(),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// toValidatorRegistration converts the registration of the request to its
+// consensus type.
+func toValidatorRegistration(
+	msg vtypes.ValidatorRegistration,
+) (*ctypes.ValidatorRegistration, error) {
+	gasLimit, err := utils.U64FromString(msg.GasLimit)
+	if err != nil {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest, "invalid gas limit %q", msg.GasLimit,
+		)
+	}
+	timestamp, err := utils.U64FromString(msg.Timestamp)
+	if err != nil {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest, "invalid timestamp %q", msg.Timestamp,
+		)
+	}
+	return &ctypes.ValidatorRegistration{
+		FeeRecipient: msg.FeeRecipient,
+		GasLimit:     gasLimit,
+		Timestamp:    timestamp,
+		Pubkey:       msg.Pubkey,
+	}, nil
+}
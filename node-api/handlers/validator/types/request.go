@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// ProposerPreparation is the fee recipient registration of a validator.
+type ProposerPreparation struct {
+	ValidatorIndex string                  `json:"validator_index"`
+	FeeRecipient   common.ExecutionAddress `json:"fee_recipient"`
+}
+
+// PrepareBeaconProposerRequest is the request body of the prepare beacon
+// proposer endpoint.
+type PrepareBeaconProposerRequest []ProposerPreparation
+
+// ValidatorRegistration is the payload preferences registration of a
+// validator, as defined in the builder specification.
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     string                  `json:"gas_limit"`
+	Timestamp    string                  `json:"timestamp"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	Message   ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature   `json:"signature"`
+}
+
+// RegisterValidatorRequest is the request body of the register validator
+// endpoint.
+type RegisterValidatorRequest []SignedValidatorRegistration
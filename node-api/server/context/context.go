@@ -20,7 +20,10 @@
 
 package context
 
+import "net/http"
+
 type Context interface {
 	Bind(any) error
 	Validate(any) error
+	Request() *http.Request
 }
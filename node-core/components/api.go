@@ -56,8 +56,8 @@ type NodeAPIBackendInput[
 
 func ProvideNodeAPIBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockT any,
-	BeaconBlockBodyT any,
+	BeaconBlockT backend.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT backend.BeaconBlockBody,
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconStateMarshallableT,
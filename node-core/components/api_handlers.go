@@ -22,6 +22,8 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
@@ -30,6 +32,12 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	simulateapi "github.com/berachain/beacon-kit/node-api/handlers/simulate"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/payload/registration"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 type NodeAPIHandlersInput[
@@ -60,6 +68,10 @@ type NodeAPIHandlersInput[
 		BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
+	SimulateAPIHandler *simulateapi.Handler[
+		NodeAPIContextT, *Validator, WithdrawalT,
+	]
+	ValidatorAPIHandler *validatorapi.Handler[NodeAPIContextT, *Validator]
 }
 
 func ProvideNodeAPIHandlers[
@@ -91,6 +103,8 @@ func ProvideNodeAPIHandlers[
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.SimulateAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
@@ -137,10 +151,25 @@ func ProvideNodeAPIEventsHandler[
 	return eventsapi.NewHandler[NodeAPIContextT]()
 }
 
+type NodeAPINodeHandlerInput[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT client.PayloadAttributes,
+] struct {
+	depinject.In
+	EngineClient *client.EngineClient[
+		ExecutionPayloadT,
+		PayloadAttributesT,
+	]
+}
+
 func ProvideNodeAPINodeHandler[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT client.PayloadAttributes,
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+](
+	in NodeAPINodeHandlerInput[ExecutionPayloadT, PayloadAttributesT],
+) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](in.EngineClient)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -175,3 +204,32 @@ func ProvideNodeAPIProofHandler[
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPISimulateHandler[
+	NodeAPIContextT NodeAPIContext,
+	WithdrawalT any,
+](
+	b NodeAPISimulateBackend[*ctypes.BeaconBlock, *Validator, WithdrawalT],
+) *simulateapi.Handler[NodeAPIContextT, *Validator, WithdrawalT] {
+	return simulateapi.NewHandler[NodeAPIContextT, *Validator, WithdrawalT](b)
+}
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](
+	b NodeAPIBackend[
+		BeaconStateT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	registrations *registration.Registry,
+	chainSpec common.ChainSpec,
+	signer crypto.BLSSigner,
+) *validatorapi.Handler[NodeAPIContextT, *Validator] {
+	return validatorapi.NewHandler[NodeAPIContextT, *Validator](
+		b, registrations, chainSpec, signer,
+	)
+}
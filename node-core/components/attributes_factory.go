@@ -22,19 +22,19 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/config"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/payload/registration"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 type AttributesFactoryInput[LoggerT any] struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    LoggerT
+	ChainSpec     common.ChainSpec
+	FeeRecipients *registration.Registry
+	Logger        LoggerT
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -61,6 +61,6 @@ func ProvideAttributesFactory[
 	](
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.FeeRecipients,
 	), nil
 }
@@ -26,10 +26,14 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/storage/archive"
 	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/pebbledb"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
 )
@@ -38,9 +42,11 @@ import (
 // function for the depinject framework.
 type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
-	AppOpts   config.AppOptions
-	ChainSpec common.ChainSpec
-	Logger    LoggerT
+	AppOpts       config.AppOptions
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAvailibilityStore provides the availability store.
@@ -52,20 +58,51 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store[BeaconBlockBodyT], error) {
-	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	blobArchive, err := archive.New(
+		in.Config.BlobArchive, homeDir, in.Logger.With("service", "da-archive"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	compression := in.Config.AvailabilityStore.Compression
+	// Validated before opening the database, so as not to leave it open.
+	if err = dastore.ValidateCompression(compression); err != nil {
+		return nil, err
+	}
+	opts := []dastore.Option{
+		dastore.WithCompression(compression),
+		dastore.WithTelemetrySink(in.TelemetrySink),
+	}
+	if blobArchive != nil {
+		opts = append(opts, dastore.WithArchive(
+			blobArchive, homeDir+"/data/blobs-archive-cursor",
+		))
+	}
+
+	var db dastore.IndexDB
+	switch backend := in.Config.AvailabilityStore.Backend; backend {
+	case "", dastore.BackendFileDB:
+		db = filedb.NewRangeDB(
 			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
+				filedb.WithRootDirectory(homeDir+"/data/blobs"),
 				filedb.WithFileExtension("ssz"),
 				filedb.WithDirectoryPermissions(os.ModePerm),
 				filedb.WithLogger(in.Logger),
 			),
-		),
+		)
+	case dastore.BackendPebble:
+		if db, err = pebbledb.Open(homeDir + "/data"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrapf(dastore.ErrUnknownBackend, "%q", backend)
+	}
+
+	return dastore.New[BeaconBlockBodyT](
+		db,
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
-	), nil
+		opts...,
+	)
 }
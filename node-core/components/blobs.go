@@ -36,21 +36,89 @@ import (
 
 // BlobProofVerifierInput is the input for the
 // dep inject framework.
-type BlobProofVerifierInput struct {
+type BlobProofVerifierInput[LoggerT any] struct {
 	depinject.In
 	AppOpts          config.AppOptions
 	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
+	Logger           LoggerT
+	TelemetrySink    *metrics.TelemetrySink
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
-// application.
-func ProvideBlobProofVerifier(
-	in BlobProofVerifierInput,
+// application. Unless disabled, the verifier is self-tested against a known
+// vector and falls back to go-kzg-4844 if c-kzg-4844 is unavailable.
+func ProvideBlobProofVerifier[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobProofVerifierInput[LoggerT],
+) (kzg.BlobProofVerifier, error) {
+	var (
+		logger   = in.Logger.With("service", "kzg")
+		impl     = cast.ToString(in.AppOpts.Get(flags.KZGImplementation))
+		selfTest = cast.ToBool(in.AppOpts.Get(flags.KZGSelfTest))
+		vecPath  = cast.ToString(in.AppOpts.Get(flags.KZGSelfTestVectorPath))
+		verifier kzg.BlobProofVerifier
+		err      error
+	)
+	if !selfTest {
+		verifier, err = kzg.NewBlobProofVerifier(impl, in.JSONTrustedSetup)
+	} else {
+		verifier, err = selfTestedBlobProofVerifier(
+			logger, in.TelemetrySink, impl, in.JSONTrustedSetup, vecPath,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if verifier.GetImplementation() != impl {
+		logger.Warn(
+			"KZG implementation unavailable, falling back",
+			"requested", impl,
+			"using", verifier.GetImplementation(),
+		)
+	}
+	return verifier, nil
+}
+
+// selfTestedBlobProofVerifier creates the verifier for impl, self-tested
+// against the vector at vecPath, or the built-in one if empty, and reports
+// the result of each implementation tested.
+func selfTestedBlobProofVerifier[LoggerT log.Logger](
+	logger LoggerT,
+	sink *metrics.TelemetrySink,
+	impl string,
+	ts *gokzg4844.JSONTrustedSetup,
+	vecPath string,
 ) (kzg.BlobProofVerifier, error) {
-	return kzg.NewBlobProofVerifier(
-		cast.ToString(in.AppOpts.Get(flags.KZGImplementation)),
-		in.JSONTrustedSetup,
+	vec, err := kzg.ReadSelfTestVector(vecPath)
+	if err != nil {
+		return nil, err
+	}
+	verifier, results, err := kzg.NewSelfTestedBlobProofVerifier(
+		impl, ts, vec,
 	)
+	for _, res := range results {
+		if res.Err != nil {
+			logger.Error(
+				"KZG self-test failed",
+				"implementation", res.Implementation,
+				"error", res.Err,
+			)
+			continue
+		}
+		logger.Info(
+			"KZG self-test passed",
+			"implementation", res.Implementation,
+			"latency", res.Latency,
+		)
+		sink.SetGauge(
+			"beacon_kit.da.kzg.self_test_latency",
+			res.Latency.Microseconds(),
+			"kzg_implementation", res.Implementation,
+		)
+	}
+	return verifier, err
 }
 
 // BlobProcessorIn is the input for the BlobProcessor.
@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/da/da"
+	"github.com/berachain/beacon-kit/da/gossip"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/deposit"
@@ -85,6 +86,7 @@ type ChainServiceInput[
 		AvailabilityStoreT, ConsensusSidecarsT, BlobSidecarsT,
 	]
 	TelemetrySink         *metrics.TelemetrySink
+	SidecarGossip         *gossip.Reactor
 	BlockStore            BeaconBlockStoreT
 	DepositStore          DepositStoreT
 	BeaconDepositContract DepositContractT
@@ -160,6 +162,7 @@ func ProvideChainService[
 		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
 		in.StorageBackend,
 		in.BlobProcessor,
+		in.SidecarGossip,
 		in.BlockStore,
 		in.DepositStore,
 		in.BeaconDepositContract,
@@ -168,6 +171,7 @@ func ProvideChainService[
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
+		in.Signer,
 		in.StateProcessor,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
@@ -26,11 +26,15 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/da/gossip"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
 	"github.com/berachain/beacon-kit/primitives/common"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
@@ -47,7 +51,30 @@ func ProvideCometBFTService[
 	appOpts config.AppOptions,
 	chainSpec common.ChainSpec,
 	telemetrySink *metrics.TelemetrySink,
+	sidecarGossip *gossip.Reactor,
+	hosted signer.HostedPrivValidators,
+	keystore *signer.KeystorePrivValidator,
+	slashingProtection *protection.DB,
 ) *cometbft.Service[LoggerT] {
+	hostedPVs := make([]cmttypes.PrivValidator, len(hosted))
+	for i, pv := range hosted {
+		hostedPVs[i] = pv
+	}
+	options := append(
+		builder.DefaultServiceOptions[LoggerT](appOpts),
+		cometbft.SetCustomReactor[LoggerT](gossip.ReactorName, sidecarGossip),
+		cometbft.SetHostedPrivValidators[LoggerT](hostedPVs),
+	)
+	if keystore != nil {
+		options = append(options, cometbft.SetPrivValidator[LoggerT](keystore))
+	}
+	if slashingProtection != nil {
+		options = append(options, cometbft.SetPrivValidatorWrapper[LoggerT](
+			func(pv cmttypes.PrivValidator) cmttypes.PrivValidator {
+				return protection.NewPrivValidator(pv, slashingProtection)
+			},
+		))
+	}
 	return cometbft.NewService(
 		storeKey,
 		logger,
@@ -57,6 +84,6 @@ func ProvideCometBFTService[
 		cmtCfg,
 		chainSpec,
 		telemetrySink,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		options...,
 	)
 }
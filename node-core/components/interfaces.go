@@ -24,12 +24,16 @@ import (
 	stdbytes "bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	simulatetypes "github.com/berachain/beacon-kit/node-api/handlers/simulate/types"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
@@ -67,11 +71,17 @@ type (
 			slot math.Slot,
 			timestamp uint64,
 			prevHeadRoot [32]byte,
+			proposer crypto.BLSPubkey,
 		) (PayloadAttributesT, error)
+		// SuggestedFeeRecipient returns the fee recipient of the payloads
+		// built for proposer.
+		SuggestedFeeRecipient(proposer crypto.BLSPubkey) common.ExecutionAddress
 	}
 
 	// AvailabilityStore is the interface for the availability store.
 	AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
+		// Basic closes the store when the node stops.
+		service.Basic
 		IndexDB
 		// IsDataAvailable ensures that all blobs referenced in the block are
 		// securely stored before it returns without an error.
@@ -79,6 +89,9 @@ type (
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, BlobSidecarsT) error
+		// GetBlobSidecars returns the sidecars stored for the slot, reading
+		// them from the archive once pruned.
+		GetBlobSidecars(math.Slot) (*datypes.BlobSidecars, error)
 	}
 
 	ConsensusBlock[BeaconBlockT any] interface {
@@ -152,6 +165,12 @@ type (
 		GetDeposits() []DepositT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+		// GetExecutionRequests returns the execution requests of the beacon
+		// block body, nil before Electra.
+		GetExecutionRequests() *ctypes.ExecutionRequests
+		// GetDepositRequests returns the EIP-6110 deposit requests of the
+		// beacon block body, nil before Electra.
+		GetDepositRequests() []DepositT
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
 		SetRandaoReveal(crypto.BLSSignature)
 		// SetEth1Data sets the Eth1 data of the beacon block body.
@@ -169,6 +188,9 @@ type (
 		// SetBlobKzgCommitments sets the blob KZG commitments of the beacon
 		// block body.
 		SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+		// SetExecutionRequests sets the execution requests of the beacon
+		// block body.
+		SetExecutionRequests(*ctypes.ExecutionRequests)
 	}
 
 	// BeaconStateMarshallable represents an interface for a beacon state
@@ -181,6 +203,8 @@ type (
 	] interface {
 		constraints.SSZMarshallableRootable
 		GetTree() (*fastssz.Node, error)
+		// Version returns the fork version the state is encoded for.
+		Version() uint32
 		// New returns a new instance of the BeaconStateMarshallable.
 		New(
 			forkVersion uint32,
@@ -199,6 +223,8 @@ type (
 			nextWithdrawalIndex uint64,
 			nextWithdrawalValidatorIndex math.U64,
 			slashings []math.U64, totalSlashing math.U64,
+			depositRequestsStartIndex uint64,
+			pendingDeposits []*ctypes.Deposit,
 		) (T, error)
 	}
 
@@ -217,6 +243,7 @@ type (
 		// VerifySidecars verifies the blobs and ensures they match the local
 		// state.
 		VerifySidecars(
+			ctx context.Context,
 			sidecars ConsensusSidecarsT,
 		) error
 	}
@@ -251,6 +278,7 @@ type (
 		VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
 		VerifyKZGProofs(scs BlobSidecarsT) error
 		VerifySidecars(
+			ctx context.Context,
 			sidecars BlobSidecarsT,
 			kzgOffset uint64,
 			blkHeader *ctypes.BeaconBlockHeader,
@@ -371,6 +399,9 @@ type (
 		// HasEth1WithdrawalCredentials returns true if the deposit has eth1
 		// withdrawal credentials.
 		HasEth1WithdrawalCredentials() bool
+		// HasCompoundingWithdrawalCredentials returns true if the deposit has
+		// compounding withdrawal credentials.
+		HasCompoundingWithdrawalCredentials() bool
 		// VerifySignature verifies the deposit and creates a validator.
 		VerifySignature(
 			forkData ForkDataT,
@@ -513,6 +544,8 @@ type (
 		GetBlockHash() common.ExecutionHash
 		// GetParentHash returns the parent hash.
 		GetParentHash() common.ExecutionHash
+		// GetGasLimit returns the gas limit.
+		GetGasLimit() math.U64
 	}
 
 	// 	Fork[T any] interface {
@@ -547,6 +580,7 @@ type (
 	IndexDB interface {
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
+		GetByIndex(index uint64) ([][]byte, error)
 		Prune(start uint64, end uint64) error
 	}
 
@@ -566,6 +600,7 @@ type (
 			parentBlockRoot common.Root,
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
+			proposer crypto.BLSPubkey,
 		) (*engineprimitives.PayloadID, error)
 		// SendForceHeadFCU sends a force head FCU request.
 		SendForceHeadFCU(
@@ -578,6 +613,7 @@ type (
 			ctx context.Context,
 			slot math.Slot,
 			parentBlockRoot common.Root,
+			proposer crypto.BLSPubkey,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 		// RequestPayloadSync requests a payload for the given slot and
 		// blocks until the payload is delivered.
@@ -589,6 +625,7 @@ type (
 			parentBlockRoot common.Root,
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
+			proposer crypto.BLSPubkey,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	}
 
@@ -843,6 +880,18 @@ type (
 		SetEth1DepositIndex(
 			index uint64,
 		) error
+		// GetDepositRequestsStartIndex retrieves the index of the first
+		// EIP-6110 deposit request.
+		GetDepositRequestsStartIndex() (uint64, error)
+		// SetDepositRequestsStartIndex sets the index of the first EIP-6110
+		// deposit request.
+		SetDepositRequestsStartIndex(index uint64) error
+		// GetPendingDeposits retrieves the EIP-6110 deposit requests waiting
+		// for the legacy deposits to be processed.
+		GetPendingDeposits() ([]*ctypes.Deposit, error)
+		// SetPendingDeposits sets the EIP-6110 deposit requests waiting for
+		// the legacy deposits to be processed.
+		SetPendingDeposits(deposits []*ctypes.Deposit) error
 		// GetBalance retrieves the balance of a validator.
 		GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 		// SetBalance sets the balance of a validator.
@@ -1041,6 +1090,8 @@ type (
 	WriteOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 		SetEth1Data(*ctypes.Eth1Data) error
 		SetEth1DepositIndex(uint64) error
+		SetDepositRequestsStartIndex(uint64) error
+		SetPendingDeposits([]*ctypes.Deposit) error
 		SetLatestExecutionPayloadHeader(
 			ExecutionPayloadHeaderT,
 		) error
@@ -1050,6 +1101,8 @@ type (
 	ReadOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 		GetEth1Data() (*ctypes.Eth1Data, error)
 		GetEth1DepositIndex() (uint64, error)
+		GetDepositRequestsStartIndex() (uint64, error)
+		GetPendingDeposits() ([]*ctypes.Deposit, error)
 		GetLatestExecutionPayloadHeader() (
 			ExecutionPayloadHeaderT, error,
 		)
@@ -1057,7 +1110,7 @@ type (
 
 	// ReadOnlyWithdrawals only has read access to withdrawal methods.
 	ReadOnlyWithdrawals[WithdrawalT any] interface {
-		EVMInflationWithdrawal() WithdrawalT
+		EVMInflationWithdrawal(slot math.Slot) WithdrawalT
 		ExpectedWithdrawals() ([]WithdrawalT, error)
 	}
 )
@@ -1071,6 +1124,7 @@ type (
 	NodeAPIContext interface {
 		Bind(any) error
 		Validate(any) error
+		Request() *http.Request
 	}
 
 	// Engine is a generic interface for an API engine.
@@ -1124,6 +1178,21 @@ type (
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 	}
 
+	// NodeAPISimulateBackend is the interface for backend of the simulate
+	// API.
+	NodeAPISimulateBackend[
+		BeaconBlockT, ValidatorT, WithdrawalT any,
+	] interface {
+		ChainSpec() common.ChainSpec
+		ValidatorBackend[ValidatorT]
+		SimulateBlock(
+			ctx context.Context,
+			blk BeaconBlockT,
+			proposerAddress []byte,
+			skipPayloadVerification bool,
+		) (*simulatetypes.BlockSimulation[WithdrawalT], error)
+	}
+
 	GenesisBackend interface {
 		GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 	}
@@ -1141,6 +1210,7 @@ type (
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+		BlobSidecarsAtSlot(slot math.Slot) (*datypes.BlobSidecars, error)
 	}
 
 	StateBackend[BeaconStateT, ForkT any] interface {
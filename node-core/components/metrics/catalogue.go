@@ -0,0 +1,424 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+// Kind is the Prometheus type a metric key is exported as.
+type Kind uint8
+
+const (
+	// KindCounter is a monotonically increasing counter.
+	KindCounter Kind = iota
+	// KindGauge is a value that can go up and down.
+	KindGauge
+	// KindHistogram is a duration distribution, observed in seconds.
+	KindHistogram
+)
+
+// String returns the Prometheus name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindCounter:
+		return "counter"
+	case KindGauge:
+		return "gauge"
+	case KindHistogram:
+		return "histogram"
+	default:
+		return "unknown"
+	}
+}
+
+// Descriptor declares a metric key emitted through the TelemetrySink.
+type Descriptor struct {
+	// Key is the key passed to the sink, e.g. "beacon_kit.runtime.version".
+	Key string
+	// Kind is the type the key is exported as.
+	Kind Kind
+	// Help is the help text exported with the metric.
+	Help string
+	// Labels are the label names kept when exporting. Any other label
+	// passed to the sink, e.g. a slot or an error string, is dropped to keep
+	// cardinality bounded.
+	Labels []string
+	// Buckets are the histogram buckets, in seconds. Ignored for counters
+	// and gauges, DurationBuckets is used if empty.
+	Buckets []float64
+}
+
+//nolint:gochecknoglobals // read-only bucket presets.
+var (
+	// DurationBuckets suits ABCI calls, Engine API calls and state
+	// transitions, from 1ms to 10s.
+	DurationBuckets = []float64{
+		.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10,
+	}
+	// FastDurationBuckets suits proof computations and verifications, from
+	// 100µs to 1s.
+	FastDurationBuckets = []float64{
+		.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, 1,
+	}
+)
+
+// DefaultCatalogue returns the descriptors of every key emitted by
+// beacon-kit. Keys are part of the public interface of the node: renaming one
+// breaks dashboards, so changes here must be reflected in README.md.
+//
+//nolint:funlen // it is a list.
+func DefaultCatalogue() []Descriptor {
+	return []Descriptor{
+		// Runtime.
+		{
+			Key:  "beacon_kit.runtime.prepare_proposal_duration",
+			Kind: KindHistogram,
+			Help: "Time spent in ABCI PrepareProposal.",
+		},
+		{
+			Key:  "beacon_kit.runtime.process_proposal_duration",
+			Kind: KindHistogram,
+			Help: "Time spent in ABCI ProcessProposal.",
+		},
+		{
+			Key:    "beacon_kit.runtime.version",
+			Kind:   KindGauge,
+			Help:   "Always 1, labelled with the node and EL versions.",
+			Labels: []string{"version", "system", "eth_version", "eth_name"},
+		},
+		{
+			Key:    "beacon_kit.runtime.version.reported",
+			Kind:   KindCounter,
+			Help:   "Number of times the node version was reported.",
+			Labels: []string{"version", "system"},
+		},
+
+		// Blockchain.
+		{
+			Key:  "beacon_kit.beacon.blockchain.state_transition_duration",
+			Kind: KindHistogram,
+			Help: "Time spent executing the state transition in FinalizeBlock.",
+		},
+		{
+			Key:  "beacon_kit.blockchain.state_root_verification_duration",
+			Kind: KindHistogram,
+			Help: "Time spent verifying the state root of an incoming block.",
+		},
+		{
+			Key:  "beacon_kit.blockchain.rebuild_payload_for_rejected_block_success",
+			Kind: KindCounter,
+			Help: "Payloads rebuilt after rejecting an incoming block.",
+		},
+		{
+			Key:  "beacon_kit.blockchain.rebuild_payload_for_rejected_block_failure",
+			Kind: KindCounter,
+			Help: "Failed payload rebuilds after rejecting an incoming block.",
+		},
+		{
+			Key:  "beacon_kit.blockchain.optimistic_payload_build_success",
+			Kind: KindCounter,
+			Help: "Optimistic payload builds started for the next slot.",
+		},
+		{
+			Key:  "beacon_kit.blockchain.optimistic_payload_build_failure",
+			Kind: KindCounter,
+			Help: "Optimistic payload builds that failed to start.",
+		},
+		{
+			Key:  "beacon_kit.execution.deposit.failed_to_get_block_logs",
+			Kind: KindCounter,
+			Help: "EL blocks whose deposit logs could not be fetched.",
+		},
+
+		// Validator.
+		{
+			Key:  "beacon_kit.validator.request_block_for_proposal_duration",
+			Kind: KindHistogram,
+			Help: "Time spent building a block and its sidecars.",
+		},
+		{
+			Key:  "beacon_kit.validator.state_root_computation_duration",
+			Kind: KindHistogram,
+			Help: "Time spent computing the state root of an outgoing block.",
+		},
+		{
+			Key:  "beacon_kit.validator.failed_to_retrieve_payload",
+			Kind: KindCounter,
+			Help: "Proposals that fell back to a synchronous payload build.",
+		},
+
+		// State processor.
+		{
+			Key:  "beacon_kit.state.payload_consensus_timestamp_diff",
+			Kind: KindGauge,
+			Help: "Payload timestamp minus consensus timestamp, in seconds.",
+		},
+
+		// Data availability.
+		{
+			Key:     "beacon_kit.da.blob.processor.verify_blobs_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent verifying the sidecars of a proposal.",
+			Labels:  []string{"num_sidecars"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.processor.process_blob_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent persisting the sidecars of a block.",
+			Labels:  []string{"num_sidecars"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.verifier.verify_blobs_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent verifying sidecar headers and proofs.",
+			Labels:  []string{"num_sidecars", "kzg_implementation"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.verifier.verify_inclusion_proofs_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent verifying commitment inclusion proofs.",
+			Labels:  []string{"num_sidecars"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.verifier.verify_kzg_proofs_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent verifying KZG blob proofs.",
+			Labels:  []string{"num_sidecars", "kzg_implementation"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.factory.build_sidecar_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent building the sidecars of a block.",
+			Labels:  []string{"num_sidecars"},
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.factory.build_kzg_inclusion_proof_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent building a single KZG inclusion proof.",
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.factory.build_block_body_proof_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent building the block body part of a proof.",
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:     "beacon_kit.da.blob.factory.build_commitment_proof_duration",
+			Kind:    KindHistogram,
+			Help:    "Time spent building the commitment part of a proof.",
+			Buckets: FastDurationBuckets,
+		},
+		{
+			Key:    "beacon_kit.da.kzg.self_test_latency",
+			Kind:   KindGauge,
+			Help:   "Blob proof verification time of the startup self-test, in microseconds.",
+			Labels: []string{"kzg_implementation"},
+		},
+		{
+			Key:    "beacon_kit.da.store.compression_ratio",
+			Kind:   KindGauge,
+			Help:   "Stored size of the last persisted sidecars, in basis points of their raw size.",
+			Labels: []string{"algorithm"},
+		},
+
+		// Execution engine.
+		{
+			Key:    "beacon_kit.execution.engine.new_payload",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls made by the execution engine.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_valid",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls answered with VALID.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_accepted_syncing_payload_status",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls answered with ACCEPTED or SYNCING.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_invalid_payload_status",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls answered with INVALID.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_json_rpc_error",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls that failed with a JSON-RPC error.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_undefined_error",
+			Kind:   KindCounter,
+			Help:   "NewPayload calls that failed with any other error.",
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update",
+			Kind:   KindCounter,
+			Help:   "ForkchoiceUpdated calls made by the execution engine.",
+			Labels: []string{"has_payload_attributes"},
+		},
+		{
+			Key:  "beacon_kit.execution.engine.forkchoice_update_valid",
+			Kind: KindCounter,
+			Help: "ForkchoiceUpdated calls answered with VALID.",
+		},
+		{
+			Key:  "beacon_kit.execution.engine.forkchoice_update_accepted_syncing",
+			Kind: KindCounter,
+			Help: "ForkchoiceUpdated calls answered with ACCEPTED or SYNCING.",
+		},
+		{
+			Key:  "beacon_kit.execution.engine.forkchoice_update_invalid",
+			Kind: KindCounter,
+			Help: "ForkchoiceUpdated calls answered with INVALID.",
+		},
+		{
+			Key:  "beacon_kit.execution.engine.forkchoice_update_json_rpc_error",
+			Kind: KindCounter,
+			Help: "ForkchoiceUpdated calls that failed with a JSON-RPC error.",
+		},
+		{
+			Key:  "beacon_kit.execution.engine.forkchoice_update_undefined_error",
+			Kind: KindCounter,
+			Help: "ForkchoiceUpdated calls that failed with any other error.",
+		},
+
+		// Execution client.
+		{
+			Key:  "beacon_kit.execution.client.forkchoice_update_duration",
+			Kind: KindHistogram,
+			Help: "Latency of engine_forkchoiceUpdated calls.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.new_payload_duration",
+			Kind: KindHistogram,
+			Help: "Latency of engine_newPayload calls.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.get_payload_duration",
+			Kind: KindHistogram,
+			Help: "Latency of engine_getPayload calls.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.forkchoice_update_duration_timeout",
+			Kind: KindCounter,
+			Help: "engine_forkchoiceUpdated calls that timed out.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.new_payload_duration_timeout",
+			Kind: KindCounter,
+			Help: "engine_newPayload calls that timed out.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.get_payload_duration_timeout",
+			Kind: KindCounter,
+			Help: "engine_getPayload calls that timed out.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.failover",
+			Kind: KindCounter,
+			Help: "Switches of the active execution client.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.healthy_endpoints",
+			Kind: KindGauge,
+			Help: "Execution clients that passed their last health check.",
+		},
+		{
+			Key:    "beacon_kit.execution.client.circuit_breaker_state",
+			Kind:   KindGauge,
+			Help:   "Circuit breaker state: 0 closed, 1 open, 2 half-open.",
+			Labels: []string{"endpoint"},
+		},
+		{
+			Key:    "beacon_kit.execution.client.circuit_breaker_opened",
+			Kind:   KindCounter,
+			Help:   "Times the circuit breaker of an execution client opened.",
+			Labels: []string{"endpoint"},
+		},
+		{
+			Key:  "beacon_kit.execution.client.http_timeout",
+			Kind: KindCounter,
+			Help: "HTTP requests to the execution client that timed out.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.parse_error",
+			Kind: KindCounter,
+			Help: "JSON-RPC parse errors returned by the execution client.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.invalid_request",
+			Kind: KindCounter,
+			Help: "JSON-RPC invalid request errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.method_not_found",
+			Kind: KindCounter,
+			Help: "JSON-RPC method not found errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.invalid_params",
+			Kind: KindCounter,
+			Help: "JSON-RPC invalid params errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.internal_error",
+			Kind: KindCounter,
+			Help: "JSON-RPC internal errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.unknown_payload_error",
+			Kind: KindCounter,
+			Help: "Engine API unknown payload errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.invalid_forkchoice_state",
+			Kind: KindCounter,
+			Help: "Engine API invalid forkchoice state errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.invalid_payload_attributes",
+			Kind: KindCounter,
+			Help: "Engine API invalid payload attributes errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.request_too_large",
+			Kind: KindCounter,
+			Help: "Engine API request too large errors.",
+		},
+		{
+			Key:  "beacon_kit.execution.client.internal_server_error",
+			Kind: KindCounter,
+			Help: "Engine API internal server errors.",
+		},
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// keyRegexp matches metric key literals in the Go sources.
+var keyRegexp = regexp.MustCompile(`"(beacon_kit\.[a-z0-9_.]+)"`)
+
+// TestDefaultCatalogue_CoversSources checks that every metric key literal in
+// the repository is declared in the catalogue and documented in README.md.
+func TestDefaultCatalogue_CoversSources(t *testing.T) {
+	declared := make(map[string]struct{})
+	for _, d := range metrics.DefaultCatalogue() {
+		declared[d.Key] = struct{}{}
+	}
+
+	readme, err := os.ReadFile("README.md")
+	require.NoError(t, err)
+	for key := range declared {
+		require.Contains(t, string(readme), "`"+key+"`")
+	}
+
+	root := filepath.Join("..", "..", "..")
+	err = filepath.WalkDir(root, func(
+		path string, entry fs.DirEntry, err error,
+	) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ".go") ||
+			strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		//#nosec:G304 // test reads the repository sources.
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, m := range keyRegexp.FindAllStringSubmatch(string(src), -1) {
+			key := m[1]
+			_, ok := declared[key]
+			// Timeout counters are derived by appending a suffix.
+			_, okTimeout := declared[key+"_timeout"]
+			require.True(
+				t, ok || okTimeout || key == "beacon_kit.metrics.undeclared",
+				"%s in %s is not in the catalogue", key, path,
+			)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import "github.com/berachain/beacon-kit/errors"
+
+// ErrUnknownSink is returned when the configured sink is not supported.
+var ErrUnknownSink = errors.New("unknown metrics sink")
+
+const (
+	// SinkTelemetry forwards metrics to the cosmos-sdk telemetry.
+	SinkTelemetry = "telemetry"
+	// SinkPrometheus records metrics in a typed Prometheus registry.
+	SinkPrometheus = "prometheus"
+)
+
+// Config is the configuration for the metrics sink.
+type Config struct {
+	// Sink selects where metrics are recorded, either "telemetry" or
+	// "prometheus".
+	Sink string `mapstructure:"sink"`
+}
+
+// DefaultConfig returns the default configuration for the metrics sink.
+func DefaultConfig() Config {
+	return Config{
+		Sink: SinkTelemetry,
+	}
+}
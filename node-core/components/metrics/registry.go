@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// ErrDuplicateKey is returned when a catalogue declares a key twice.
+	ErrDuplicateKey = errors.New("duplicate metric key")
+	// ErrUnknownKind is returned when a descriptor has an unknown kind.
+	ErrUnknownKind = errors.New("unknown metric kind")
+)
+
+// undeclaredKey is the counter incremented when a key that is not part of
+// the catalogue is emitted.
+const undeclaredKey = "beacon_kit.metrics.undeclared"
+
+// Registry is a typed Prometheus registry built from a catalogue of
+// descriptors. Every key is registered up front with its declared labels, so
+// the exported series do not depend on which code paths ran first.
+type Registry struct {
+	// descs holds the descriptor of every registered key.
+	descs map[string]Descriptor
+	// counters holds the counter vectors, by key.
+	counters map[string]*prometheus.CounterVec
+	// gauges holds the gauge vectors, by key.
+	gauges map[string]*prometheus.GaugeVec
+	// histograms holds the histogram vectors, by key.
+	histograms map[string]*prometheus.HistogramVec
+	// undeclared counts keys emitted without a descriptor.
+	undeclared *prometheus.CounterVec
+}
+
+// NewRegistry creates the collectors for the given catalogue and registers
+// them with reg.
+func NewRegistry(
+	reg prometheus.Registerer,
+	catalogue []Descriptor,
+) (*Registry, error) {
+	r := &Registry{
+		descs:      make(map[string]Descriptor, len(catalogue)),
+		counters:   make(map[string]*prometheus.CounterVec),
+		gauges:     make(map[string]*prometheus.GaugeVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
+		undeclared: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: PrometheusName(undeclaredKey, KindCounter),
+			Help: "Metric keys emitted without a catalogue entry.",
+		}, []string{"key"}),
+	}
+	if err := reg.Register(r.undeclared); err != nil {
+		return nil, err
+	}
+
+	for _, d := range catalogue {
+		if _, ok := r.descs[d.Key]; ok {
+			return nil, errors.Wrap(ErrDuplicateKey, d.Key)
+		}
+		r.descs[d.Key] = d
+
+		var collector prometheus.Collector
+		name := PrometheusName(d.Key, d.Kind)
+		switch d.Kind {
+		case KindCounter:
+			vec := prometheus.NewCounterVec(prometheus.CounterOpts{
+				Name: name, Help: d.Help,
+			}, d.Labels)
+			r.counters[d.Key], collector = vec, vec
+		case KindGauge:
+			vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: name, Help: d.Help,
+			}, d.Labels)
+			r.gauges[d.Key], collector = vec, vec
+		case KindHistogram:
+			buckets := d.Buckets
+			if len(buckets) == 0 {
+				buckets = DurationBuckets
+			}
+			vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Name: name, Help: d.Help, Buckets: buckets,
+			}, d.Labels)
+			r.histograms[d.Key], collector = vec, vec
+		default:
+			return nil, errors.Wrapf(ErrUnknownKind, "%d for %s", d.Kind, d.Key)
+		}
+
+		if err := reg.Register(collector); err != nil {
+			return nil, errors.Wrapf(err, "registering %s", d.Key)
+		}
+	}
+	return r, nil
+}
+
+// IncrementCounter increments the counter registered for key.
+func (r *Registry) IncrementCounter(key string, args ...string) {
+	vec, ok := r.counters[key]
+	if !ok {
+		r.undeclared.WithLabelValues(key).Inc()
+		return
+	}
+	vec.WithLabelValues(r.labelValues(key, args)...).Inc()
+}
+
+// SetGauge sets the gauge registered for key.
+func (r *Registry) SetGauge(key string, value int64, args ...string) {
+	vec, ok := r.gauges[key]
+	if !ok {
+		r.undeclared.WithLabelValues(key).Inc()
+		return
+	}
+	vec.WithLabelValues(r.labelValues(key, args)...).Set(float64(value))
+}
+
+// MeasureSince observes the seconds elapsed since start in the histogram
+// registered for key.
+func (r *Registry) MeasureSince(key string, start time.Time, args ...string) {
+	vec, ok := r.histograms[key]
+	if !ok {
+		r.undeclared.WithLabelValues(key).Inc()
+		return
+	}
+	vec.WithLabelValues(r.labelValues(key, args)...).Observe(
+		time.Since(start).Seconds(),
+	)
+}
+
+// labelValues picks the values of the declared labels of key out of the
+// key-value pairs in args. Missing labels are exported as empty strings and
+// undeclared ones are dropped.
+//
+//nolint:mnd // args are pairs.
+func (r *Registry) labelValues(key string, args []string) []string {
+	labels := r.descs[key].Labels
+	values := make([]string, len(labels))
+	for i := 0; i+1 < len(args); i += 2 {
+		for j, name := range labels {
+			if args[i] == name {
+				values[j] = args[i+1]
+			}
+		}
+	}
+	return values
+}
+
+// PrometheusName returns the name a key is exported under. Dots are flattened
+// to underscores, matching what go-metrics produces, and histograms get a
+// "_seconds" suffix since they replace go-metrics millisecond summaries.
+func PrometheusName(key string, kind Kind) string {
+	name := strings.NewReplacer(".", "_", "-", "_").Replace(key)
+	if kind == KindHistogram {
+		name += "_seconds"
+	}
+	return name
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry(
+	t *testing.T,
+	catalogue []metrics.Descriptor,
+) (*metrics.Registry, *prometheus.Registry) {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+	r, err := metrics.NewRegistry(reg, catalogue)
+	require.NoError(t, err)
+	return r, reg
+}
+
+func TestRegistry_Counter(t *testing.T) {
+	r, reg := newTestRegistry(t, []metrics.Descriptor{{
+		Key:    "beacon_kit.test.counter",
+		Kind:   metrics.KindCounter,
+		Help:   "test counter",
+		Labels: []string{"is_optimistic"},
+	}})
+	sink := metrics.NewPrometheusTelemetrySink(r)
+
+	sink.IncrementCounter(
+		"beacon_kit.test.counter", "is_optimistic", "true", "slot", "12",
+	)
+	sink.IncrementCounter("beacon_kit.test.counter", "is_optimistic", "true")
+
+	expected := `
+# HELP beacon_kit_test_counter test counter
+# TYPE beacon_kit_test_counter counter
+beacon_kit_test_counter{is_optimistic="true"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		reg, strings.NewReader(expected), "beacon_kit_test_counter",
+	))
+}
+
+func TestRegistry_Gauge(t *testing.T) {
+	r, reg := newTestRegistry(t, []metrics.Descriptor{{
+		Key:  "beacon_kit.test.gauge",
+		Kind: metrics.KindGauge,
+		Help: "test gauge",
+	}})
+
+	r.SetGauge("beacon_kit.test.gauge", 3)
+	r.SetGauge("beacon_kit.test.gauge", -7)
+
+	expected := `
+# HELP beacon_kit_test_gauge test gauge
+# TYPE beacon_kit_test_gauge gauge
+beacon_kit_test_gauge -7
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		reg, strings.NewReader(expected), "beacon_kit_test_gauge",
+	))
+}
+
+func TestRegistry_Histogram(t *testing.T) {
+	r, reg := newTestRegistry(t, []metrics.Descriptor{{
+		Key:    "beacon_kit.test.duration",
+		Kind:   metrics.KindHistogram,
+		Help:   "test duration",
+		Labels: []string{"num_sidecars"},
+	}})
+
+	r.MeasureSince(
+		"beacon_kit.test.duration", time.Now(), "num_sidecars", "6",
+	)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	var found bool
+	for _, f := range families {
+		if f.GetName() != "beacon_kit_test_duration_seconds" {
+			continue
+		}
+		found = true
+		require.Len(t, f.GetMetric(), 1)
+		m := f.GetMetric()[0]
+		require.Equal(t, "num_sidecars", m.GetLabel()[0].GetName())
+		require.Equal(t, "6", m.GetLabel()[0].GetValue())
+		require.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+		require.Len(
+			t, m.GetHistogram().GetBucket(), len(metrics.DurationBuckets),
+		)
+	}
+	require.True(t, found)
+}
+
+func TestRegistry_Undeclared(t *testing.T) {
+	r, reg := newTestRegistry(t, nil)
+
+	r.IncrementCounter("beacon_kit.test.unknown")
+	r.SetGauge("beacon_kit.test.unknown", 1)
+
+	expected := `
+# HELP beacon_kit_metrics_undeclared Metric keys emitted without a catalogue entry.
+# TYPE beacon_kit_metrics_undeclared counter
+beacon_kit_metrics_undeclared{key="beacon_kit.test.unknown"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		reg, strings.NewReader(expected), "beacon_kit_metrics_undeclared",
+	))
+}
+
+func TestRegistry_DuplicateKey(t *testing.T) {
+	d := metrics.Descriptor{
+		Key:  "beacon_kit.test.counter",
+		Kind: metrics.KindCounter,
+		Help: "test counter",
+	}
+	_, err := metrics.NewRegistry(
+		prometheus.NewRegistry(), []metrics.Descriptor{d, d},
+	)
+	require.ErrorIs(t, err, metrics.ErrDuplicateKey)
+}
+
+func TestDefaultCatalogue_Registers(t *testing.T) {
+	_, err := metrics.NewRegistry(
+		prometheus.NewRegistry(), metrics.DefaultCatalogue(),
+	)
+	require.NoError(t, err)
+}
@@ -27,22 +27,41 @@ import (
 	"github.com/hashicorp/go-metrics"
 )
 
-type TelemetrySink struct{}
+// TelemetrySink forwards metrics to the cosmos-sdk telemetry (go-metrics) by
+// default, or to a typed Prometheus registry when one is set.
+type TelemetrySink struct {
+	// registry, if set, receives every metric instead of go-metrics.
+	registry *Registry
+}
 
 // NewTelemetrySink creates a new TelemetrySink.
 func NewTelemetrySink() TelemetrySink {
 	return TelemetrySink{}
 }
 
+// NewPrometheusTelemetrySink creates a new TelemetrySink that records
+// metrics natively in the given registry.
+func NewPrometheusTelemetrySink(registry *Registry) TelemetrySink {
+	return TelemetrySink{registry: registry}
+}
+
 // IncrementCounter increments a counter metric identified by the provided
 // keys.
-func (TelemetrySink) IncrementCounter(key string, args ...string) {
+func (s TelemetrySink) IncrementCounter(key string, args ...string) {
+	if s.registry != nil {
+		s.registry.IncrementCounter(key, args...)
+		return
+	}
 	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
 }
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
+func (s TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	if s.registry != nil {
+		s.registry.SetGauge(key, value, args...)
+		return
+	}
 	telemetry.SetGaugeWithLabels(
 		[]string{key},
 		float32(value),
@@ -52,7 +71,13 @@ func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
 
 // MeasureSince measures the time since the provided start time and records
 // the duration in a metric identified by the provided key.
-func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
+func (s TelemetrySink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	if s.registry != nil {
+		s.registry.MeasureSince(key, start, args...)
+		return
+	}
 	if !telemetry.IsTelemetryEnabled() {
 		return
 	}
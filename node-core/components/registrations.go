@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"os"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/payload/registration"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ValidatorRegistrationsInput is the input for the dep inject framework.
+type ValidatorRegistrationsInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+}
+
+// ProvideValidatorRegistrations provides the registry of the payload
+// preferences of the validators hosted by the node, persisted in the node
+// home.
+func ProvideValidatorRegistrations[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ValidatorRegistrationsInput[LoggerT],
+) *registration.Registry {
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	logger := in.Logger.With("service", "validator-registrations")
+	return registration.NewRegistry(
+		filedb.NewDB(
+			filedb.WithRootDirectory(homeDir+"/data/validator_registrations"),
+			filedb.WithFileExtension("json"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(logger),
+		),
+		logger,
+		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.Config.Validator.TargetGasLimit,
+	)
+}
@@ -33,6 +33,7 @@ import (
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/services/version"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -68,7 +69,8 @@ type ServiceRegistryInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
-	ChainService *blockchain.Service[
+	AvailabilityStore AvailabilityStoreT
+	ChainService      *blockchain.Service[
 		AvailabilityStoreT, DepositStoreT,
 		ConsensusBlockT, BeaconBlockT, BeaconBlockBodyT,
 		BeaconStateT, BeaconBlockStoreT, DepositT,
@@ -89,6 +91,7 @@ type ServiceRegistryInput[
 	]
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
+	TracingService   *tracing.Service
 	ValidatorService *validator.Service[
 		*AttestationData, BeaconBlockT, BeaconBlockBodyT,
 		BeaconStateT, BlobSidecarT, BlobSidecarsT, DepositT, DepositStoreT,
@@ -141,6 +144,7 @@ func ProvideServiceRegistry[
 ) *service.Registry {
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
+		service.WithService(in.TracingService),
 		service.WithService(in.ValidatorService),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
@@ -148,5 +152,7 @@ func ProvideServiceRegistry[
 		service.WithService(in.TelemetryService),
 		service.WithService(in.ChainService),
 		service.WithService(in.CometBFTService),
+		// Stopped last, once nothing writes to it anymore.
+		service.WithService(in.AvailabilityStore),
 	)
 }
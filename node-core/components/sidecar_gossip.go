@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/da/gossip"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SidecarGossipInput is the input for the sidecar gossip provider.
+type SidecarGossipInput[
+	AvailabilityStoreT any,
+	LoggerT any,
+] struct {
+	depinject.In
+	AvailabilityStore AvailabilityStoreT
+	Config            *config.Config
+	Logger            LoggerT
+}
+
+// ProvideSidecarGossip provides the reactor gossiping blob sidecars outside
+// of the proposal. It is registered with CometBFT on every node, so that
+// gossiped sidecars are accepted regardless of the local configuration.
+func ProvideSidecarGossip[
+	AvailabilityStoreT interface {
+		GetBlobSidecars(math.Slot) (*datypes.BlobSidecars, error)
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SidecarGossipInput[AvailabilityStoreT, LoggerT],
+) *gossip.Reactor {
+	return gossip.NewReactor(
+		in.Config.SidecarGossip,
+		in.Logger.With("service", "sidecar-gossip"),
+		func(slot uint64) ([]byte, common.Root, error) {
+			sidecars, err := in.AvailabilityStore.GetBlobSidecars(
+				math.Slot(slot),
+			)
+			if err != nil || sidecars.IsNil() || sidecars.Len() == 0 {
+				return nil, common.Root{}, err
+			}
+			header := sidecars.Get(0).GetBeaconBlockHeader()
+			bz, err := sidecars.MarshalSSZ()
+			return bz, header.HashTreeRoot(), err
+		},
+	)
+}
@@ -26,39 +26,163 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
 )
 
+// SlashingProtectionInput is the input for the dep inject framework.
+type SlashingProtectionInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config `optional:"true"`
+}
+
+// ProvideSlashingProtection opens the slashing protection database shared by
+// the BLS signer and the CometBFT private validators. It returns nil if
+// slashing protection is disabled.
+func ProvideSlashingProtection(
+	in SlashingProtectionInput,
+) (*protection.DB, error) {
+	if in.Config == nil || !in.Config.SlashingProtection.Enabled {
+		return nil, nil
+	}
+	return protection.Open(resolvePath(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+		in.Config.SlashingProtection.Path,
+	))
+}
+
+// KeystorePrivValidatorInput is the input for the dep inject framework.
+type KeystorePrivValidatorInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config `optional:"true"`
+}
+
+// ProvideKeystorePrivValidator decrypts the keystore of the node, shared by
+// the BLS signer and CometBFT. It returns nil if no keystore is configured.
+func ProvideKeystorePrivValidator(
+	in KeystorePrivValidatorInput,
+) (*signer.KeystorePrivValidator, error) {
+	if in.Config == nil || !in.Config.Keystore.Enabled() {
+		return nil, nil
+	}
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	return signer.NewKeystorePrivValidator(
+		resolvePath(homeDir, in.Config.Keystore.Path),
+		resolvePath(homeDir, in.Config.Keystore.PasswordFile),
+		resolvePath(homeDir, cast.ToString(
+			in.AppOpts.Get("priv_validator_key_file"),
+		)),
+		resolvePath(homeDir, cast.ToString(
+			in.AppOpts.Get("priv_validator_state_file"),
+		)),
+	)
+}
+
 // BlsSignerInput is the input for the dep inject framework.
 type BlsSignerInput struct {
 	depinject.In
-	AppOpts config.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	AppOpts            config.AppOptions
+	Config             *config.Config                `optional:"true"`
+	Keystore           *signer.KeystorePrivValidator `optional:"true"`
+	PrivKey            LegacyKey                     `optional:"true"`
+	SlashingProtection *protection.DB                `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	blsSigner, err := newBlsSigner(in)
+	if err != nil {
+		return nil, err
+	}
+	if in.SlashingProtection == nil {
+		return blsSigner, nil
+	}
+	return protection.NewSigner(blsSigner, in.SlashingProtection), nil
+}
+
+// HostedPrivValidatorsInput is the input for the dep inject framework.
+type HostedPrivValidatorsInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config `optional:"true"`
+}
+
+// ProvideHostedPrivValidators provides the CometBFT private validators hosted
+// by the node in addition to its own.
+func ProvideHostedPrivValidators(
+	in HostedPrivValidatorsInput,
+) (signer.HostedPrivValidators, error) {
+	if in.Config == nil ||
+		len(in.Config.HostedValidators.PrivValidatorKeyFiles) == 0 {
+		return nil, nil
+	}
+	cfg := in.Config.HostedValidators
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	keyFiles := make([]string, len(cfg.PrivValidatorKeyFiles))
+	for i, keyFile := range cfg.PrivValidatorKeyFiles {
+		keyFiles[i] = resolvePath(homeDir, keyFile)
+	}
+	return signer.LoadHostedPrivValidators(
+		keyFiles, resolvePath(homeDir, cfg.StateDir),
+	)
+}
+
+// KeyringInput is the input for the dep inject framework.
+type KeyringInput struct {
+	depinject.In
+	Signer crypto.BLSSigner
+	Hosted signer.HostedPrivValidators
+}
+
+// ProvideKeyring provides the signers of all the validators hosted by the
+// node, the BLS signer of the node first.
+func ProvideKeyring(in KeyringInput) (*signer.Keyring, error) {
+	signers := []crypto.BLSSigner{in.Signer}
+	for _, pv := range in.Hosted {
+		var hosted crypto.BLSSigner = &signer.BLSSigner{PrivValidator: pv}
+		// Hosted validators share the slashing protection of the node.
+		if protected, ok := in.Signer.(*protection.Signer); ok {
+			hosted = protected.Wrap(hosted)
+		}
+		signers = append(signers, hosted)
+	}
+	return signer.NewKeyring(signers...)
+}
+
+// newBlsSigner creates the signer selected by the configuration.
+func newBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	// A remote signer takes precedence over any local key.
+	if in.Config != nil && in.Config.RemoteSigner.Enabled() {
+		return signer.NewRemoteSigner(in.Config.RemoteSigner)
+	}
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	// An encrypted keystore takes precedence over the CometBFT key file.
+	if in.Keystore != nil &&
+		in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
+		return &signer.BLSSigner{PrivValidator: in.Keystore}, nil
+	}
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
-		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
-		privValKeyFile := cast.ToString(
+		privValKeyFile := resolvePath(homeDir, cast.ToString(
 			in.AppOpts.Get("priv_validator_key_file"),
-		)
-		privValStateFile := cast.ToString(
+		))
+		privValStateFile := resolvePath(homeDir, cast.ToString(
 			in.AppOpts.Get("priv_validator_state_file"),
-		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
-		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
-		}
+		))
 		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
 	}
 	return signer.NewLegacySigner(in.PrivKey)
 }
+
+// resolvePath joins path with homeDir if it is not absolute.
+func resolvePath(homeDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(homeDir, path)
+}
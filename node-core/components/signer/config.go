@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "time"
+
+const defaultRemoteTimeout = 2 * time.Second
+
+// RemoteConfig is the configuration of the remote signer.
+type RemoteConfig struct {
+	// URL is the base URL of the Web3Signer-compatible signer. The remote
+	// signer is used instead of the local key when it is set.
+	URL string `mapstructure:"url"`
+	// Pubkey is the hex-encoded BLS public key the signer signs for.
+	Pubkey string `mapstructure:"pubkey"`
+	// CACertPath is the CA certificate used to verify the signer. The system
+	// pool is used when empty.
+	CACertPath string `mapstructure:"ca-cert-path"`
+	// ClientCertPath is the client certificate presented to the signer.
+	ClientCertPath string `mapstructure:"client-cert-path"`
+	// ClientKeyPath is the private key of the client certificate.
+	ClientKeyPath string `mapstructure:"client-key-path"`
+	// Timeout is the deadline of a single signing request.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultRemoteConfig returns the default configuration of the remote
+// signer, which leaves it disabled.
+func DefaultRemoteConfig() RemoteConfig {
+	return RemoteConfig{
+		Timeout: defaultRemoteTimeout,
+	}
+}
+
+// Enabled returns true if a remote signer is configured.
+func (c RemoteConfig) Enabled() bool {
+	return c.URL != ""
+}
+
+// KeystoreConfig is the configuration of the EIP-2335 keystore holding the
+// validator key.
+type KeystoreConfig struct {
+	// Path is the path of the keystore. The CometBFT private validator key
+	// is used when it is empty.
+	Path string `mapstructure:"path"`
+	// PasswordFile is the path of the file holding the keystore password.
+	PasswordFile string `mapstructure:"password-file"`
+}
+
+// Enabled returns true if a keystore is configured.
+func (c KeystoreConfig) Enabled() bool {
+	return c.Path != ""
+}
+
+const defaultHostedStateDir = "data/hosted_validators"
+
+// HostedConfig is the configuration of the validators hosted by the node in
+// addition to its CometBFT private validator.
+type HostedConfig struct {
+	// PrivValidatorKeyFiles are the CometBFT private validator key files of
+	// the additional validators.
+	PrivValidatorKeyFiles []string `mapstructure:"priv-validator-key-files"`
+	// StateDir is the directory holding the last sign state of each
+	// additional validator.
+	StateDir string `mapstructure:"state-dir"`
+}
+
+// DefaultHostedConfig returns the default configuration of the hosted
+// validators, which hosts none besides the CometBFT private validator.
+func DefaultHostedConfig() HostedConfig {
+	return HostedConfig{
+		StateDir: defaultHostedStateDir,
+	}
+}
@@ -38,4 +38,38 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUnsupportedSigningRequest is returned when the remote signer is asked
+	// to sign an opaque message.
+	ErrUnsupportedSigningRequest = errors.New(
+		"remote signer only signs typed requests",
+	)
+	// ErrRemoteSignerRequest is returned when the remote signer rejects a
+	// signing request.
+	ErrRemoteSignerRequest = errors.New("remote signer request failed")
+	// ErrInvalidRemoteSignerPubkey is returned when the configured remote
+	// signer pubkey has an invalid length.
+	ErrInvalidRemoteSignerPubkey = errors.New(
+		"invalid remote signer pubkey length",
+	)
+	// ErrInvalidCACert is returned when no certificate could be parsed from
+	// the remote signer CA file.
+	ErrInvalidCACert = errors.New("invalid remote signer CA certificate")
+	// ErrUnsupportedKeystore is returned when a keystore uses a version,
+	// function or parameter that is not supported.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+	// ErrInvalidKeystorePassword is returned when the keystore checksum does
+	// not match, i.e. the password is wrong.
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+	// ErrKeystoreKeyMismatch is returned when the CometBFT private validator
+	// key file holds a different key than the keystore.
+	ErrKeystoreKeyMismatch = errors.New(
+		"keystore and CometBFT private validator keys differ",
+	)
+	// ErrUnknownValidator is returned when a validator is not hosted by the
+	// keyring.
+	ErrUnknownValidator = errors.New("validator not hosted by this node")
+	// ErrDuplicateHostedValidator is returned when the same validator key is
+	// hosted more than once.
+	ErrDuplicateHostedValidator = errors.New("duplicate hosted validator")
 )
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// stateDirPermissions are the permissions of the directory holding the last
+// sign state of the hosted validators.
+const stateDirPermissions = 0o700
+
+// HostedPrivValidators are the CometBFT private validators hosted by the node
+// in addition to its own.
+type HostedPrivValidators []*privval.FilePV
+
+// LoadHostedPrivValidators loads the CometBFT private validators of the given
+// key files. The last sign state of each validator is kept in stateDir under
+// its address, and starts empty the first time the validator is hosted.
+func LoadHostedPrivValidators(
+	keyFiles []string,
+	stateDir string,
+) (HostedPrivValidators, error) {
+	if err := os.MkdirAll(stateDir, stateDirPermissions); err != nil {
+		return nil, err
+	}
+
+	pvs := make(HostedPrivValidators, 0, len(keyFiles))
+	for _, keyFile := range keyFiles {
+		// CometBFT exits instead of failing when the key file is missing.
+		if _, err := os.Stat(keyFile); err != nil {
+			return nil, err
+		}
+
+		pv := privval.LoadFilePVEmptyState(keyFile, "")
+		stateFile := filepath.Join(stateDir, pv.GetAddress().String()+".json")
+		_, err := os.Stat(stateFile)
+		switch {
+		case err == nil:
+			pv = privval.LoadFilePV(keyFile, stateFile)
+		case errors.Is(err, os.ErrNotExist):
+			pv = privval.LoadFilePVEmptyState(keyFile, stateFile)
+			pv.LastSignState.Save()
+		default:
+			return nil, err
+		}
+		pvs = append(pvs, pv)
+	}
+	return pvs, nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Keyring holds the signers of all the validators hosted by the node, the
+// first one being the primary validator of the node.
+type Keyring struct {
+	// signers are the hosted signers, in the order they were configured.
+	signers []crypto.BLSSigner
+	// byAddress indexes the signers by their CometBFT address.
+	byAddress map[string]crypto.BLSSigner
+}
+
+// NewKeyring creates a keyring hosting the given signers.
+func NewKeyring(signers ...crypto.BLSSigner) (*Keyring, error) {
+	if len(signers) == 0 {
+		return nil, ErrValidatorPrivateKeyRequired
+	}
+	k := &Keyring{
+		signers:   signers,
+		byAddress: make(map[string]crypto.BLSSigner, len(signers)),
+	}
+	for _, signer := range signers {
+		address, err := crypto.GetAddressFromPubKey(signer.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := k.byAddress[string(address)]; ok {
+			return nil, errors.Wrapf(
+				ErrDuplicateHostedValidator, "%s", signer.PublicKey(),
+			)
+		}
+		k.byAddress[string(address)] = signer
+	}
+	return k, nil
+}
+
+// Primary returns the signer of the primary validator of the node.
+func (k *Keyring) Primary() crypto.BLSSigner {
+	return k.signers[0]
+}
+
+// Signers returns the signers of all the hosted validators.
+func (k *Keyring) Signers() []crypto.BLSSigner {
+	return k.signers
+}
+
+// SignerByAddress returns the signer of the hosted validator with the given
+// CometBFT address.
+func (k *Keyring) SignerByAddress(address []byte) (crypto.BLSSigner, error) {
+	signer, ok := k.byAddress[string(address)]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownValidator, "address %X", address)
+	}
+	return signer, nil
+}
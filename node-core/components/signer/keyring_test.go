@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigner(t *testing.T, seed byte) *signer.LegacySigner {
+	t.Helper()
+	var key signer.LegacyKey
+	key[len(key)-1] = seed
+	s, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+	return s
+}
+
+func TestKeyring(t *testing.T) {
+	primary, hosted := newTestSigner(t, 1), newTestSigner(t, 2)
+	keyring, err := signer.NewKeyring(primary, hosted)
+	require.NoError(t, err)
+	require.Equal(t, primary, keyring.Primary())
+	require.Len(t, keyring.Signers(), 2)
+
+	for _, s := range []*signer.LegacySigner{primary, hosted} {
+		address, aErr := crypto.GetAddressFromPubKey(s.PublicKey())
+		require.NoError(t, aErr)
+		got, sErr := keyring.SignerByAddress(address)
+		require.NoError(t, sErr)
+		require.Equal(t, s.PublicKey(), got.PublicKey())
+	}
+
+	address, err := crypto.GetAddressFromPubKey(
+		newTestSigner(t, 3).PublicKey(),
+	)
+	require.NoError(t, err)
+	_, err = keyring.SignerByAddress(address)
+	require.ErrorIs(t, err, signer.ErrUnknownValidator)
+}
+
+func TestKeyring_Rejects(t *testing.T) {
+	_, err := signer.NewKeyring()
+	require.ErrorIs(t, err, signer.ErrValidatorPrivateKeyRequired)
+
+	_, err = signer.NewKeyring(newTestSigner(t, 1), newTestSigner(t, 1))
+	require.ErrorIs(t, err, signer.ErrDuplicateHostedValidator)
+}
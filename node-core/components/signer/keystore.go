@@ -0,0 +1,405 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// KDFScrypt is the scrypt key derivation function.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function.
+	KDFPBKDF2 = "pbkdf2"
+
+	keystoreVersion  = 4
+	checksumFunction = "sha256"
+	cipherFunction   = "aes-128-ctr"
+	pbkdf2PRF        = "hmac-sha256"
+
+	// Parameters recommended by EIP-2335.
+	derivedKeyLen = 32
+	saltLen       = 32
+	scryptN       = 262144
+	scryptR       = 8
+	scryptP       = 1
+	pbkdf2C       = 262144
+	decryptKeyLen = 16
+)
+
+// Keystore is an EIP-2335 BLS12-381 keystore.
+// https://eips.ethereum.org/EIPS/eip-2335
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	// Pubkey is the hex-encoded public key, without 0x prefix.
+	Pubkey  string `json:"pubkey"`
+	Path    string `json:"path"`
+	UUID    string `json:"uuid"`
+	Version int    `json:"version"`
+}
+
+// KeystoreCrypto is the crypto module of a keystore.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a function, its parameters and its message.
+type KeystoreModule struct {
+	Function string         `json:"function"`
+	Params   map[string]any `json:"params"`
+	Message  string         `json:"message"`
+}
+
+// EncryptKeystore encrypts the secret key into a keystore with password,
+// using the given key derivation function and the parameters recommended
+// by EIP-2335.
+func EncryptKeystore(
+	secret LegacyKey,
+	password string,
+	kdf string,
+) (*Keystore, error) {
+	signer, err := NewLegacySigner(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLen)
+	iv := make([]byte, aes.BlockSize)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err = rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	kdfModule := KeystoreModule{Function: kdf}
+	switch kdf {
+	case KDFScrypt:
+		kdfModule.Params = map[string]any{
+			"dklen": derivedKeyLen, "n": scryptN, "r": scryptR, "p": scryptP,
+			"salt": hex.EncodeToString(salt),
+		}
+	case KDFPBKDF2:
+		kdfModule.Params = map[string]any{
+			"dklen": derivedKeyLen, "c": pbkdf2C, "prf": pbkdf2PRF,
+			"salt": hex.EncodeToString(salt),
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf %s", kdf)
+	}
+
+	dk, err := deriveKey(kdfModule, password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(dk[:decryptKeyLen], iv, secret[:])
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey := signer.PublicKey()
+	return &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF: kdfModule,
+			Checksum: KeystoreModule{
+				Function: checksumFunction,
+				Params:   map[string]any{},
+				Message:  hex.EncodeToString(checksum(dk, cipherText)),
+			},
+			Cipher: KeystoreModule{
+				Function: cipherFunction,
+				Params:   map[string]any{"iv": hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Pubkey:  hex.EncodeToString(pubkey[:]),
+		UUID:    uuid.NewString(),
+		Version: keystoreVersion,
+	}, nil
+}
+
+// Decrypt returns the secret key of the keystore.
+func (k *Keystore) Decrypt(password string) (LegacyKey, error) {
+	if k.Version != keystoreVersion {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "version %d", k.Version,
+		)
+	}
+	if k.Crypto.Checksum.Function != checksumFunction ||
+		k.Crypto.Cipher.Function != cipherFunction {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "checksum %s, cipher %s",
+			k.Crypto.Checksum.Function, k.Crypto.Cipher.Function,
+		)
+	}
+
+	dk, err := deriveKey(k.Crypto.KDF, password)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	want, err := hex.DecodeString(k.Crypto.Checksum.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if subtle.ConstantTimeCompare(checksum(dk, cipherText), want) != 1 {
+		return LegacyKey{}, ErrInvalidKeystorePassword
+	}
+
+	iv, err := hexParam(k.Crypto.Cipher.Params, "iv")
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	secret, err := aes128CTR(dk[:decryptKeyLen], iv, cipherText)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(secret) != constants.BLSSecretKeyLength {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+	return LegacyKey(secret), nil
+}
+
+// LoadKeystore reads a keystore from path.
+func LoadKeystore(path string) (*Keystore, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keystore := new(Keystore)
+	if err = json.Unmarshal(bz, keystore); err != nil {
+		return nil, errors.Wrapf(err, "parsing keystore %s", path)
+	}
+	return keystore, nil
+}
+
+// ReadPasswordFile reads a keystore password from path. Trailing line breaks
+// do not need trimming since control characters are not part of EIP-2335
+// passwords.
+func ReadPasswordFile(path string) (string, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// KeystorePrivValidator is the CometBFT private validator of the key held in
+// a keystore. The key is only kept in memory, so CometBFT signs without the
+// plaintext private validator key file.
+type KeystorePrivValidator struct {
+	*privval.FilePV
+}
+
+// NewKeystorePrivValidator creates the CometBFT private validator of the
+// keystore at keystorePath, decrypted with the password in passwordPath. Its
+// last sign state is kept in stateFilePath. If keyFilePath holds a CometBFT
+// private validator key, it must be the key of the keystore.
+func NewKeystorePrivValidator(
+	keystorePath string,
+	passwordPath string,
+	keyFilePath string,
+	stateFilePath string,
+) (*KeystorePrivValidator, error) {
+	keystore, err := LoadKeystore(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	password, err := ReadPasswordFile(passwordPath)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := keystore.Decrypt(password)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting keystore %s", keystorePath)
+	}
+	privKey, err := bls12381.NewPrivateKeyFromBytes(secret[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The key file is never written, so that the key stays encrypted.
+	pv := privval.NewFilePV(&privKey, "", stateFilePath)
+	if err = checkKeyFile(keyFilePath, pv); err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(stateFilePath)
+	switch {
+	case err == nil:
+		if err = cmtjson.Unmarshal(bz, &pv.LastSignState); err != nil {
+			return nil, errors.Wrapf(
+				err, "reading private validator state %s", stateFilePath,
+			)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		pv.LastSignState.Save()
+	default:
+		return nil, err
+	}
+	return &KeystorePrivValidator{FilePV: pv}, nil
+}
+
+// checkKeyFile ensures that the CometBFT private validator key in keyFilePath,
+// if any, is the key of pv.
+func checkKeyFile(keyFilePath string, pv *privval.FilePV) error {
+	if keyFilePath == "" {
+		return nil
+	}
+	if _, err := os.Stat(keyFilePath); errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	keyFilePV := privval.LoadFilePVEmptyState(keyFilePath, "")
+	if !keyFilePV.Key.PubKey.Equals(pv.Key.PubKey) {
+		return errors.Wrapf(
+			ErrKeystoreKeyMismatch, "keystore %s, %s %s",
+			pv.Key.Address, keyFilePath, keyFilePV.Key.Address,
+		)
+	}
+	return nil
+}
+
+// deriveKey derives the decryption key from password with the kdf module.
+func deriveKey(kdf KeystoreModule, password string) ([]byte, error) {
+	pw := processPassword(password)
+	salt, err := hexParam(kdf.Params, "salt")
+	if err != nil {
+		return nil, err
+	}
+	keyLen, err := intParam(kdf.Params, "dklen")
+	if err != nil {
+		return nil, err
+	}
+	if keyLen < derivedKeyLen {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "dklen %d", keyLen)
+	}
+
+	switch kdf.Function {
+	case KDFScrypt:
+		var n, r, p int
+		if n, err = intParam(kdf.Params, "n"); err != nil {
+			return nil, err
+		}
+		if r, err = intParam(kdf.Params, "r"); err != nil {
+			return nil, err
+		}
+		if p, err = intParam(kdf.Params, "p"); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(pw, salt, n, r, p, keyLen)
+	case KDFPBKDF2:
+		if prf, _ := kdf.Params["prf"].(string); prf != pbkdf2PRF {
+			return nil, errors.Wrapf(ErrUnsupportedKeystore, "prf %s", prf)
+		}
+		var c int
+		if c, err = intParam(kdf.Params, "c"); err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(pw, salt, c, keyLen, sha256.New), nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "kdf %s", kdf.Function,
+		)
+	}
+}
+
+// processPassword normalizes password to NFKD and strips the C0, C1 and
+// Delete control codes, as required by EIP-2335.
+func processPassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
+
+// checksum is the EIP-2335 sha256 checksum of the cipher message.
+func checksum(dk []byte, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(dk[decryptKeyLen:derivedKeyLen])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+// aes128CTR encrypts or decrypts msg with AES-128-CTR.
+func aes128CTR(key, iv, msg []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "iv length %d", len(iv))
+	}
+	out := make([]byte, len(msg))
+	cipher.NewCTR(block, iv).XORKeyStream(out, msg)
+	return out, nil
+}
+
+// hexParam returns the hex-encoded bytes parameter name.
+func hexParam(params map[string]any, name string) ([]byte, error) {
+	s, ok := params[name].(string)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "missing %s", name)
+	}
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+// intParam returns the integer parameter name. JSON numbers are decoded as
+// float64.
+func intParam(params map[string]any, name string) (int, error) {
+	switch v := params[name].(type) {
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	default:
+		return 0, errors.Wrapf(ErrUnsupportedKeystore, "missing %s", name)
+	}
+}
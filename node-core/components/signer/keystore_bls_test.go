@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package signer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystore_EncryptRoundTrip(t *testing.T) {
+	secret := signer.LegacyKey(hex.MustToBytes(vectorSecret))
+	keystore, err := signer.EncryptKeystore(
+		secret, vectorPassword, signer.KDFPBKDF2,
+	)
+	require.NoError(t, err)
+
+	// The vector pubkey is the one of the vector secret.
+	vector, err := signer.LoadKeystore(
+		writeFile(t, "keystore.json", pbkdf2Vector),
+	)
+	require.NoError(t, err)
+	require.Equal(t, vector.Pubkey, keystore.Pubkey)
+
+	decrypted, err := keystore.Decrypt(vectorPassword)
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+}
+
+func TestNewKeystorePrivValidator(t *testing.T) {
+	dir := t.TempDir()
+	stateFile := filepath.Join(dir, "priv_validator_state.json")
+	pv, err := signer.NewKeystorePrivValidator(
+		writeFile(t, "keystore.json", scryptVector),
+		writeFile(t, "password", vectorPassword+"\n"),
+		filepath.Join(dir, "priv_validator_key.json"),
+		stateFile,
+	)
+	require.NoError(t, err)
+	s := &signer.BLSSigner{PrivValidator: pv}
+	require.Equal(t,
+		"0x9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+		s.PublicKey().String(),
+	)
+
+	// Only the last sign state is written, the key stays encrypted.
+	require.FileExists(t, stateFile)
+	require.NoFileExists(t, filepath.Join(dir, "priv_validator_key.json"))
+
+	_, err = signer.NewKeystorePrivValidator(
+		writeFile(t, "keystore.json", scryptVector),
+		writeFile(t, "password", "wrong"),
+		"",
+		stateFile,
+	)
+	require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+}
+
+func TestNewKeystorePrivValidatorKeyMismatch(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	other, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	privval.NewFilePV(other, keyFile, "").Key.Save()
+
+	_, err = signer.NewKeystorePrivValidator(
+		writeFile(t, "keystore.json", scryptVector),
+		writeFile(t, "password", vectorPassword),
+		keyFile,
+		filepath.Join(dir, "priv_validator_state.json"),
+	)
+	require.ErrorIs(t, err, signer.ErrKeystoreKeyMismatch)
+}
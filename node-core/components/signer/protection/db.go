@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	filePerm = 0o600
+	dirPerm  = 0o700
+)
+
+// DB is a slashing protection database persisted as an EIP-3076 interchange
+// document. Only the highest signed block and the highest attestation epochs
+// of every key are kept, which is all the minimal slashing conditions need.
+//
+// The document is extended with the highest height, round and step at which
+// every CometBFT key signed a proposal or a vote, guarding the messages that
+// can get a validator slashed on a beacon-kit chain.
+type DB struct {
+	// mu protects the fields below and the file.
+	mu sync.Mutex
+	// path is the file the database is persisted to.
+	path string
+	// genesisValidatorsRoot is the chain the data belongs to, zero until the
+	// first signature or import.
+	genesisValidatorsRoot bytes.B32
+	// blocks holds the highest signed block of every key.
+	blocks map[crypto.BLSPubkey]SignedBlock
+	// attestations holds the highest source and target epochs of every key.
+	attestations map[crypto.BLSPubkey]SignedAttestation
+	// consensus holds the last CometBFT message signed by every key, indexed
+	// by the address of the key.
+	consensus map[string]SignedConsensus
+}
+
+// Open opens the database persisted at path, starting empty if the file
+// does not exist.
+func Open(path string) (*DB, error) {
+	db := &DB{
+		path:         path,
+		blocks:       make(map[crypto.BLSPubkey]SignedBlock),
+		attestations: make(map[crypto.BLSPubkey]SignedAttestation),
+		consensus:    make(map[string]SignedConsensus),
+	}
+
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return db, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var interchange Interchange
+	if err = json.Unmarshal(bz, &interchange); err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", path)
+	}
+	if err = db.merge(&interchange); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// CheckAndRecordBlock returns an error if signing the block with
+// signingRoot at slot with pubkey could be slashable, and otherwise persists
+// it as signed before returning. Signing the same block again, as happens
+// when CometBFT re-proposes in a later round, is allowed.
+func (db *DB) CheckAndRecordBlock(
+	genesisValidatorsRoot bytes.B32,
+	pubkey crypto.BLSPubkey,
+	slot uint64,
+	signingRoot bytes.B32,
+) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err := db.checkGenesisValidatorsRoot(genesisValidatorsRoot); err != nil {
+		return err
+	}
+
+	if last, ok := db.blocks[pubkey]; ok {
+		switch {
+		case slot < last.Slot:
+			return errors.Wrapf(
+				ErrSlashableBlock, "slot %d is lower than signed slot %d",
+				slot, last.Slot,
+			)
+		case slot == last.Slot:
+			if last.SigningRoot != nil && *last.SigningRoot == signingRoot {
+				return nil
+			}
+			return errors.Wrapf(
+				ErrSlashableBlock, "another block was signed at slot %d", slot,
+			)
+		}
+	}
+
+	prevRoot := db.genesisValidatorsRoot
+	prev, hadPrev := db.blocks[pubkey]
+	db.genesisValidatorsRoot = genesisValidatorsRoot
+	db.blocks[pubkey] = SignedBlock{Slot: slot, SigningRoot: &signingRoot}
+	if err := db.save(); err != nil {
+		// Roll back so that memory never claims more than the disk.
+		db.genesisValidatorsRoot = prevRoot
+		if hadPrev {
+			db.blocks[pubkey] = prev
+		} else {
+			delete(db.blocks, pubkey)
+		}
+		return err
+	}
+	return nil
+}
+
+// CheckAndRecordConsensus returns an error if signing the CometBFT message
+// with signingRoot at height, round and step with the key of address could be
+// slashable, and otherwise persists it as signed before returning. Signing
+// the same message again, as happens after a restart, is allowed.
+func (db *DB) CheckAndRecordConsensus(
+	address []byte,
+	height int64,
+	round int32,
+	step int8,
+	signingRoot bytes.B32,
+) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	msg := SignedConsensus{
+		Address:     address,
+		Height:      height,
+		Round:       round,
+		Step:        step,
+		SigningRoot: signingRoot,
+	}
+	last, hadLast := db.consensus[string(address)]
+	if hadLast {
+		switch order := msg.compare(last); {
+		case order < 0:
+			return errors.Wrapf(
+				ErrSlashableConsensusMessage,
+				"%d/%d/%d is lower than signed %d/%d/%d",
+				height, round, step, last.Height, last.Round, last.Step,
+			)
+		case order == 0:
+			if last.SigningRoot == signingRoot {
+				return nil
+			}
+			return errors.Wrapf(
+				ErrSlashableConsensusMessage,
+				"another message was signed at %d/%d/%d",
+				height, round, step,
+			)
+		}
+	}
+
+	db.consensus[string(address)] = msg
+	if err := db.save(); err != nil {
+		// Roll back so that memory never claims more than the disk.
+		if hadLast {
+			db.consensus[string(address)] = last
+		} else {
+			delete(db.consensus, string(address))
+		}
+		return err
+	}
+	return nil
+}
+
+// Import merges interchange into the database and persists it.
+func (db *DB) Import(interchange *Interchange) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.merge(interchange); err != nil {
+		return err
+	}
+	return db.save()
+}
+
+// Export returns the content of the database as an interchange document.
+func (db *DB) Export() *Interchange {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.export()
+}
+
+// merge merges interchange into the database, keeping the highest slot and
+// epochs of every key, and the highest CometBFT height, round and step.
+func (db *DB) merge(interchange *Interchange) error {
+	if v := interchange.Metadata.InterchangeFormatVersion; v !=
+		InterchangeFormatVersion {
+		return errors.Wrapf(ErrUnsupportedInterchangeVersion, "%q", v)
+	}
+	if err := db.checkGenesisValidatorsRoot(
+		interchange.Metadata.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	db.genesisValidatorsRoot = interchange.Metadata.GenesisValidatorsRoot
+
+	for _, record := range interchange.Data {
+		for _, blk := range record.SignedBlocks {
+			last, ok := db.blocks[record.Pubkey]
+			switch {
+			case !ok || blk.Slot > last.Slot:
+				db.blocks[record.Pubkey] = blk
+			case blk.Slot == last.Slot && !sameRoot(blk.SigningRoot,
+				last.SigningRoot):
+				// Two different blocks at the same slot: forget the root
+				// so that neither can be signed again.
+				db.blocks[record.Pubkey] = SignedBlock{Slot: blk.Slot}
+			}
+		}
+		for _, att := range record.SignedAttestations {
+			last, ok := db.attestations[record.Pubkey]
+			if !ok {
+				db.attestations[record.Pubkey] = SignedAttestation{
+					SourceEpoch: att.SourceEpoch,
+					TargetEpoch: att.TargetEpoch,
+				}
+				continue
+			}
+			last.SourceEpoch = max(last.SourceEpoch, att.SourceEpoch)
+			last.TargetEpoch = max(last.TargetEpoch, att.TargetEpoch)
+			db.attestations[record.Pubkey] = last
+		}
+	}
+	for _, msg := range interchange.SignedConsensus {
+		last, ok := db.consensus[string(msg.Address)]
+		switch order := msg.compare(last); {
+		case !ok || order > 0:
+			db.consensus[string(msg.Address)] = msg
+		case order == 0 && msg.SigningRoot != last.SigningRoot:
+			// Two different messages at the same height, round and step:
+			// forget the root so that neither can be signed again.
+			msg.SigningRoot = bytes.B32{}
+			db.consensus[string(msg.Address)] = msg
+		}
+	}
+	return nil
+}
+
+// checkGenesisValidatorsRoot returns an error if the database belongs to
+// another chain than genesisValidatorsRoot.
+func (db *DB) checkGenesisValidatorsRoot(
+	genesisValidatorsRoot bytes.B32,
+) error {
+	if db.genesisValidatorsRoot != (bytes.B32{}) &&
+		db.genesisValidatorsRoot != genesisValidatorsRoot {
+		return errors.Wrapf(
+			ErrGenesisValidatorsRootMismatch, "have %s, got %s",
+			db.genesisValidatorsRoot, genesisValidatorsRoot,
+		)
+	}
+	return nil
+}
+
+// export builds the interchange document of the database, sorted by pubkey
+// and by address.
+func (db *DB) export() *Interchange {
+	records := make(map[crypto.BLSPubkey]*Record)
+	record := func(pubkey crypto.BLSPubkey) *Record {
+		r, ok := records[pubkey]
+		if !ok {
+			r = &Record{
+				Pubkey:             pubkey,
+				SignedBlocks:       []SignedBlock{},
+				SignedAttestations: []SignedAttestation{},
+			}
+			records[pubkey] = r
+		}
+		return r
+	}
+	for pubkey, blk := range db.blocks {
+		r := record(pubkey)
+		r.SignedBlocks = append(r.SignedBlocks, blk)
+	}
+	for pubkey, att := range db.attestations {
+		r := record(pubkey)
+		r.SignedAttestations = append(r.SignedAttestations, att)
+	}
+
+	interchange := &Interchange{
+		Metadata: Metadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    db.genesisValidatorsRoot,
+		},
+		Data: make([]Record, 0, len(records)),
+	}
+	for _, r := range records {
+		interchange.Data = append(interchange.Data, *r)
+	}
+	sort.Slice(interchange.Data, func(i, j int) bool {
+		return interchange.Data[i].Pubkey.String() <
+			interchange.Data[j].Pubkey.String()
+	})
+
+	for _, msg := range db.consensus {
+		interchange.SignedConsensus = append(
+			interchange.SignedConsensus, msg,
+		)
+	}
+	sort.Slice(interchange.SignedConsensus, func(i, j int) bool {
+		return interchange.SignedConsensus[i].Address.String() <
+			interchange.SignedConsensus[j].Address.String()
+	})
+	return interchange
+}
+
+// save atomically persists the database: the document is written and synced
+// to a temporary file which is then renamed over the previous one.
+func (db *DB) save() error {
+	bz, err := json.MarshalIndent(db.export(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(db.path), dirPerm); err != nil {
+		return err
+	}
+
+	tmp := db.path + ".tmp"
+	//#nosec:G304 // the path is provided by the operator.
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, filePerm)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, db.path)
+}
+
+// sameRoot returns true if both signing roots are known and equal.
+func sameRoot(a, b *bytes.B32) bool {
+	return a != nil && b != nil && *a == *b
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	gvr    = bytes.B32{0x01}
+	pubkey = crypto.BLSPubkey{0x02}
+)
+
+func TestDB_CheckAndRecordBlock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "slashing_protection.json")
+	db, err := protection.Open(path)
+	require.NoError(t, err)
+
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 10, bytes.B32{1}))
+	// Re-signing the same block, e.g. in a later round, is fine.
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 10, bytes.B32{1}))
+	// Another block at the same slot is not.
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(gvr, pubkey, 10, bytes.B32{2}),
+		protection.ErrSlashableBlock,
+	)
+	// Neither is a lower slot.
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(gvr, pubkey, 9, bytes.B32{1}),
+		protection.ErrSlashableBlock,
+	)
+	// Nor another chain.
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(bytes.B32{0xff}, pubkey, 11, bytes.B32{1}),
+		protection.ErrGenesisValidatorsRootMismatch,
+	)
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 11, bytes.B32{3}))
+
+	// The database survives a restart.
+	db, err = protection.Open(path)
+	require.NoError(t, err)
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(gvr, pubkey, 10, bytes.B32{1}),
+		protection.ErrSlashableBlock,
+	)
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 11, bytes.B32{3}))
+}
+
+func TestDB_ImportExport(t *testing.T) {
+	db, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 10, bytes.B32{1}))
+
+	other := crypto.BLSPubkey{0x03}
+	root := bytes.B32{4}
+	require.NoError(t, db.Import(&protection.Interchange{
+		Metadata: protection.Metadata{
+			InterchangeFormatVersion: protection.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    gvr,
+		},
+		Data: []protection.Record{
+			{
+				Pubkey: pubkey,
+				SignedBlocks: []protection.SignedBlock{
+					{Slot: 5}, {Slot: 20, SigningRoot: &root},
+				},
+			},
+			{
+				Pubkey: other,
+				SignedBlocks: []protection.SignedBlock{
+					{Slot: 7, SigningRoot: &root},
+				},
+				SignedAttestations: []protection.SignedAttestation{
+					{SourceEpoch: 1, TargetEpoch: 2},
+					{SourceEpoch: 3, TargetEpoch: 4},
+				},
+			},
+		},
+	}))
+
+	// The imported history is enforced.
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(gvr, pubkey, 15, bytes.B32{1}),
+		protection.ErrSlashableBlock,
+	)
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 20, root))
+
+	exported := db.Export()
+	require.Equal(t, gvr, exported.Metadata.GenesisValidatorsRoot)
+	require.Len(t, exported.Data, 2)
+	require.Equal(t, pubkey, exported.Data[0].Pubkey)
+	require.Equal(t, uint64(20), exported.Data[0].SignedBlocks[0].Slot)
+	require.Equal(t, other, exported.Data[1].Pubkey)
+	require.Equal(t,
+		[]protection.SignedAttestation{{SourceEpoch: 3, TargetEpoch: 4}},
+		exported.Data[1].SignedAttestations,
+	)
+}
+
+func TestDB_ImportRejects(t *testing.T) {
+	db, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	require.NoError(t, db.CheckAndRecordBlock(gvr, pubkey, 1, bytes.B32{}))
+
+	require.ErrorIs(t, db.Import(&protection.Interchange{
+		Metadata: protection.Metadata{InterchangeFormatVersion: "4"},
+	}), protection.ErrUnsupportedInterchangeVersion)
+	require.ErrorIs(t, db.Import(&protection.Interchange{
+		Metadata: protection.Metadata{
+			InterchangeFormatVersion: protection.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    bytes.B32{0xff},
+		},
+	}), protection.ErrGenesisValidatorsRootMismatch)
+}
+
+func TestDB_ConflictingImportBlocksSlot(t *testing.T) {
+	db, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	a, b := bytes.B32{1}, bytes.B32{2}
+	require.NoError(t, db.Import(&protection.Interchange{
+		Metadata: protection.Metadata{
+			InterchangeFormatVersion: protection.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    gvr,
+		},
+		Data: []protection.Record{{
+			Pubkey: pubkey,
+			SignedBlocks: []protection.SignedBlock{
+				{Slot: 3, SigningRoot: &a}, {Slot: 3, SigningRoot: &b},
+			},
+		}},
+	}))
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(gvr, pubkey, 3, a),
+		protection.ErrSlashableBlock,
+	)
+}
+
+func TestOpen_EIP3076Document(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interchange.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+  },
+  "data": [
+    {
+      "pubkey": "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+      "signed_blocks": [
+        {
+          "slot": "81952",
+          "signing_root": "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+        },
+        {
+          "slot": "81951"
+        }
+      ],
+      "signed_attestations": [
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3007",
+          "signing_root": "0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d"
+        }
+      ]
+    }
+  ]
+}`), 0o600))
+
+	db, err := protection.Open(path)
+	require.NoError(t, err)
+	exported := db.Export()
+	require.Len(t, exported.Data, 1)
+	require.Equal(t, uint64(81952), exported.Data[0].SignedBlocks[0].Slot)
+	require.Equal(t,
+		uint64(3007), exported.Data[0].SignedAttestations[0].TargetEpoch,
+	)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrSlashableBlock is returned when signing a block would conflict with
+	// a block signed before.
+	ErrSlashableBlock = errors.New("refusing to sign slashable block")
+	// ErrSlashableConsensusMessage is returned when signing a CometBFT
+	// proposal or vote would conflict with a message signed before.
+	ErrSlashableConsensusMessage = errors.New(
+		"refusing to sign slashable consensus message",
+	)
+	// ErrUnknownVoteType is returned when asked to sign a vote which is
+	// neither a prevote nor a precommit.
+	ErrUnknownVoteType = errors.New("unknown vote type")
+	// ErrGenesisValidatorsRootMismatch is returned when the slashing
+	// protection data belongs to another chain.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"genesis validators root mismatch",
+	)
+	// ErrUnsupportedInterchangeVersion is returned when importing an
+	// interchange document of an unsupported version.
+	ErrUnsupportedInterchangeVersion = errors.New(
+		"unsupported interchange format version",
+	)
+)
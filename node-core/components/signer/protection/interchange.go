@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection
+
+import (
+	"cmp"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// InterchangeFormatVersion is the supported version of the EIP-3076
+// interchange format.
+const InterchangeFormatVersion = "5"
+
+// Interchange is an EIP-3076 slashing protection interchange document.
+// https://eips.ethereum.org/EIPS/eip-3076
+type Interchange struct {
+	Metadata Metadata `json:"metadata"`
+	Data     []Record `json:"data"`
+	// SignedConsensus is a beacon-kit extension of the interchange format,
+	// holding the last CometBFT message signed by every key. Other clients
+	// ignore it.
+	SignedConsensus []SignedConsensus `json:"signed_consensus,omitempty"`
+}
+
+// Metadata is the metadata of an interchange document.
+type Metadata struct {
+	InterchangeFormatVersion string    `json:"interchange_format_version"`
+	GenesisValidatorsRoot    bytes.B32 `json:"genesis_validators_root"`
+}
+
+// Record holds what a single validator key has signed.
+type Record struct {
+	Pubkey             crypto.BLSPubkey    `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a block proposal signed by a validator.
+type SignedBlock struct {
+	Slot        uint64     `json:"slot,string"`
+	SigningRoot *bytes.B32 `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is an attestation signed by a validator. beacon-kit does
+// not produce attestations, but they are kept so that interchange documents
+// round-trip.
+type SignedAttestation struct {
+	SourceEpoch uint64     `json:"source_epoch,string"`
+	TargetEpoch uint64     `json:"target_epoch,string"`
+	SigningRoot *bytes.B32 `json:"signing_root,omitempty"`
+}
+
+// SignedConsensus is the last CometBFT proposal or vote signed by a key.
+type SignedConsensus struct {
+	// Address is the CometBFT address of the key.
+	Address bytes.Bytes `json:"address"`
+	// Height is the height of the message.
+	Height int64 `json:"height,string"`
+	// Round is the round of the message.
+	Round int32 `json:"round"`
+	// Step is the step of the message, StepPropose, StepPrevote or
+	// StepPrecommit.
+	Step int8 `json:"step"`
+	// SigningRoot is the hash of the message, without its timestamp.
+	SigningRoot bytes.B32 `json:"signing_root"`
+}
+
+// compare orders messages by height, round and step.
+func (m SignedConsensus) compare(other SignedConsensus) int {
+	switch {
+	case m.Height != other.Height:
+		return cmp.Compare(m.Height, other.Height)
+	case m.Round != other.Round:
+		return cmp.Compare(m.Round, other.Round)
+	default:
+		return cmp.Compare(m.Step, other.Step)
+	}
+}
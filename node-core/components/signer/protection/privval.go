@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// The steps of the CometBFT messages, as numbered by CometBFT.
+const (
+	StepPropose   int8 = 1
+	StepPrevote   int8 = 2
+	StepPrecommit int8 = 3
+)
+
+// PrivValidator is a CometBFT private validator that consults the slashing
+// protection database before signing proposals and votes, so that a restored
+// or duplicated priv_validator_state.json cannot get the key to double sign.
+type PrivValidator struct {
+	types.PrivValidator
+	// db is the slashing protection database.
+	db *DB
+}
+
+// NewPrivValidator wraps pv with the slashing protection of db.
+func NewPrivValidator(pv types.PrivValidator, db *DB) *PrivValidator {
+	return &PrivValidator{PrivValidator: pv, db: db}
+}
+
+// SignVote records the vote in the slashing protection database, refusing
+// to sign if it is slashable, and signs it.
+func (pv *PrivValidator) SignVote(
+	chainID string, vote *cmtproto.Vote, signExtension bool,
+) error {
+	var step int8
+	switch vote.GetType() {
+	case cmtproto.PrevoteType:
+		step = StepPrevote
+	case cmtproto.PrecommitType:
+		step = StepPrecommit
+	default:
+		return errors.Wrapf(ErrUnknownVoteType, "%s", vote.GetType())
+	}
+
+	// Re-signing a vote which only differs by its timestamp is not
+	// slashable, so the timestamp is left out of the signing root.
+	unstamped := *vote
+	unstamped.Timestamp = time.Time{}
+	if err := pv.checkAndRecord(
+		vote.GetHeight(), vote.GetRound(), step,
+		types.VoteSignBytes(chainID, &unstamped),
+	); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignVote(chainID, vote, signExtension)
+}
+
+// SignProposal records the proposal in the slashing protection database,
+// refusing to sign if it is slashable, and signs it.
+func (pv *PrivValidator) SignProposal(
+	chainID string, proposal *cmtproto.Proposal,
+) error {
+	unstamped := *proposal
+	unstamped.Timestamp = time.Time{}
+	if err := pv.checkAndRecord(
+		proposal.GetHeight(), proposal.GetRound(), StepPropose,
+		types.ProposalSignBytes(chainID, &unstamped),
+	); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignProposal(chainID, proposal)
+}
+
+// checkAndRecord checks and records the message with signBytes at height,
+// round and step for the key of the private validator.
+func (pv *PrivValidator) checkAndRecord(
+	height int64, round int32, step int8, signBytes []byte,
+) error {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return err
+	}
+	return pv.db.CheckAndRecordConsensus(
+		pubKey.Address(), height, round, step, sha256.Sum256(signBytes),
+	)
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "beacon-kit"
+
+func TestPrivValidator_SignVoteAndProposal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db.json")
+	db, err := protection.Open(path)
+	require.NoError(t, err)
+	mockPV := types.NewMockPV()
+	pv := protection.NewPrivValidator(mockPV, db)
+
+	vote := func(
+		typ cmtproto.SignedMsgType, height int64, round int32, block byte,
+	) *cmtproto.Vote {
+		hash := make([]byte, 32)
+		hash[0] = block
+		return &cmtproto.Vote{
+			Type:      typ,
+			Height:    height,
+			Round:     round,
+			BlockID:   cmtproto.BlockID{Hash: hash},
+			Timestamp: time.Now(),
+		}
+	}
+
+	require.NoError(t,
+		pv.SignVote(chainID, vote(cmtproto.PrevoteType, 10, 0, 1), false),
+	)
+	// Re-signing the same vote with another timestamp is fine.
+	require.NoError(t,
+		pv.SignVote(chainID, vote(cmtproto.PrevoteType, 10, 0, 1), false),
+	)
+	// Voting for another block at the same height, round and step is not.
+	require.ErrorIs(t,
+		pv.SignVote(chainID, vote(cmtproto.PrevoteType, 10, 0, 2), false),
+		protection.ErrSlashableConsensusMessage,
+	)
+	require.NoError(t,
+		pv.SignVote(chainID, vote(cmtproto.PrecommitType, 10, 0, 1), false),
+	)
+	require.NoError(t, pv.SignProposal(chainID, &cmtproto.Proposal{
+		Type:      cmtproto.ProposalType,
+		Height:    10,
+		Round:     1,
+		Timestamp: time.Now(),
+	}))
+
+	// The database survives a restart: going back in the round is refused.
+	db, err = protection.Open(path)
+	require.NoError(t, err)
+	pv = protection.NewPrivValidator(mockPV, db)
+	require.ErrorIs(t,
+		pv.SignVote(chainID, vote(cmtproto.PrecommitType, 10, 0, 1), false),
+		protection.ErrSlashableConsensusMessage,
+	)
+	require.NoError(t,
+		pv.SignVote(chainID, vote(cmtproto.PrevoteType, 10, 1, 1), false),
+	)
+}
+
+func TestPrivValidator_ExportImportKeepsConsensusProtection(t *testing.T) {
+	db, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	mockPV := types.NewMockPV()
+	vote := func(round int32, block byte) *cmtproto.Vote {
+		hash := make([]byte, 32)
+		hash[0] = block
+		return &cmtproto.Vote{
+			Type:      cmtproto.PrevoteType,
+			Height:    10,
+			Round:     round,
+			BlockID:   cmtproto.BlockID{Hash: hash},
+			Timestamp: time.Now(),
+		}
+	}
+	require.NoError(t,
+		protection.NewPrivValidator(mockPV, db).
+			SignVote(chainID, vote(1, 1), false),
+	)
+
+	// The validator moves to a new host through an exported file.
+	bz, err := json.Marshal(db.Export())
+	require.NoError(t, err)
+	var interchange protection.Interchange
+	require.NoError(t, json.Unmarshal(bz, &interchange))
+	require.Len(t, interchange.SignedConsensus, 1)
+
+	moved, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	require.NoError(t, moved.Import(&interchange))
+	pv := protection.NewPrivValidator(mockPV, moved)
+
+	// Another vote at the same height, round and step is refused, and so is
+	// an earlier round.
+	require.ErrorIs(t,
+		pv.SignVote(chainID, vote(1, 2), false),
+		protection.ErrSlashableConsensusMessage,
+	)
+	require.ErrorIs(t,
+		pv.SignVote(chainID, vote(0, 1), false),
+		protection.ErrSlashableConsensusMessage,
+	)
+	require.NoError(t, pv.SignVote(chainID, vote(1, 1), false))
+
+	// Importing an older message keeps the highest one.
+	older := interchange.SignedConsensus[0]
+	older.Round = 0
+	require.NoError(t, moved.Import(&protection.Interchange{
+		Metadata:        interchange.Metadata,
+		SignedConsensus: []protection.SignedConsensus{older},
+	}))
+	require.ErrorIs(t,
+		pv.SignVote(chainID, vote(0, 1), false),
+		protection.ErrSlashableConsensusMessage,
+	)
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection
+
+import (
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Signer is a BLSSigner that consults the slashing protection database
+// before signing the RANDAO reveal of a block proposal. Deposits and opaque
+// messages are not slashable and are signed as is.
+//
+// The BLS key does not sign beacon-kit blocks, CometBFT does, and its
+// proposals and votes are guarded by PrivValidator. The signing root recorded
+// for a block is the one of its RANDAO reveal. It is the same for every
+// proposal in an epoch, hence re-proposing a block at the same slot in a
+// later CometBFT round remains possible.
+type Signer struct {
+	crypto.BLSSigner
+	// db is the slashing protection database.
+	db *DB
+}
+
+// NewSigner wraps signer with the slashing protection of db.
+func NewSigner(signer crypto.BLSSigner, db *DB) *Signer {
+	return &Signer{BLSSigner: signer, db: db}
+}
+
+// Wrap wraps signer with the same slashing protection database, for nodes
+// hosting several validators.
+func (s *Signer) Wrap(signer crypto.BLSSigner) *Signer {
+	return NewSigner(signer, s.db)
+}
+
+// SignRandaoReveal records the block proposed at slot in the slashing
+// protection database, refusing to sign if it is slashable, and signs the
+// RANDAO reveal for epoch.
+func (s *Signer) SignRandaoReveal(
+	fork crypto.ForkInfo,
+	slot, epoch uint64,
+	signingRoot bytes.B32,
+) (crypto.BLSSignature, error) {
+	if err := s.db.CheckAndRecordBlock(
+		fork.GenesisValidatorsRoot, s.PublicKey(), slot, signingRoot,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if signer, ok := s.BLSSigner.(crypto.RandaoRevealSigner); ok {
+		return signer.SignRandaoReveal(fork, slot, epoch, signingRoot)
+	}
+	return s.Sign(signingRoot[:])
+}
+
+// SignDeposit signs the deposit message.
+func (s *Signer) SignDeposit(
+	deposit crypto.DepositInfo,
+	signingRoot bytes.B32,
+) (crypto.BLSSignature, error) {
+	if signer, ok := s.BLSSigner.(crypto.DepositSigner); ok {
+		return signer.SignDeposit(deposit, signingRoot)
+	}
+	return s.Sign(signingRoot[:])
+}
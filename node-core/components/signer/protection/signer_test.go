@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package protection_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer/protection"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSigner signs by echoing the first byte of the message.
+type fakeSigner struct{ signed int }
+
+func (*fakeSigner) PublicKey() crypto.BLSPubkey { return pubkey }
+
+func (f *fakeSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	f.signed++
+	return crypto.BLSSignature{msg[0]}, nil
+}
+
+func (*fakeSigner) VerifySignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	return nil
+}
+
+func TestSigner_SignRandaoReveal(t *testing.T) {
+	db, err := protection.Open(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	inner := &fakeSigner{}
+	s := protection.NewSigner(inner, db)
+	fork := crypto.ForkInfo{GenesisValidatorsRoot: gvr}
+
+	sig, err := s.SignRandaoReveal(fork, 2, 0, bytes.B32{7})
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{7}, sig)
+
+	_, err = s.SignRandaoReveal(fork, 1, 0, bytes.B32{7})
+	require.ErrorIs(t, err, protection.ErrSlashableBlock)
+	require.Equal(t, 1, inner.signed)
+
+	// Deposits are not slashable.
+	_, err = s.SignDeposit(crypto.DepositInfo{}, bytes.B32{8})
+	require.NoError(t, err)
+	require.Equal(t, 2, inner.signed)
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	pbytes "github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+const (
+	// signPath is the Web3Signer signing endpoint, followed by the pubkey.
+	signPath = "/api/v1/eth2/sign/"
+
+	typeRandaoReveal = "RANDAO_REVEAL"
+	typeDeposit      = "DEPOSIT"
+
+	// maxResponseSize bounds the size of a signer response.
+	maxResponseSize = 1 << 16
+)
+
+// RemoteSigner is a BLSSigner that delegates signing to a
+// Web3Signer-compatible remote signer, so that no key is kept on the
+// validator host. Web3Signer only signs typed requests, hence RemoteSigner
+// implements crypto.RandaoRevealSigner and crypto.DepositSigner and refuses
+// to sign opaque messages.
+type RemoteSigner struct {
+	// url is the signing endpoint for the configured pubkey.
+	url string
+	// pubkey is the public key of the remote key.
+	pubkey crypto.BLSPubkey
+	// client is the (mTLS) HTTP client used to reach the signer.
+	client *http.Client
+}
+
+// NewRemoteSigner creates a new RemoteSigner from the given configuration.
+func NewRemoteSigner(cfg RemoteConfig) (*RemoteSigner, error) {
+	pubkeyBz, err := hex.ToBytes(cfg.Pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer pubkey")
+	}
+	if len(pubkeyBz) != len(crypto.BLSPubkey{}) {
+		return nil, errors.Wrapf(
+			ErrInvalidRemoteSignerPubkey, "got %d bytes", len(pubkeyBz),
+		)
+	}
+
+	tlsCfg, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey := crypto.BLSPubkey(pubkeyBz)
+	return &RemoteSigner{
+		url:    strings.TrimSuffix(cfg.URL, "/") + signPath + pubkey.String(),
+		pubkey: pubkey,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsCfg,
+			},
+		},
+	}, nil
+}
+
+// newTLSConfig loads the CA and client certificates of cfg.
+func newTLSConfig(cfg RemoteConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CACertPath != "" {
+		caPEM, err := os.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Wrapf(ErrInvalidCACert, "%s", cfg.CACertPath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(
+			cfg.ClientCertPath, cfg.ClientKeyPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+// PublicKey returns the public key of the remote key.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign always fails, as Web3Signer does not sign opaque messages.
+func (s *RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUnsupportedSigningRequest
+}
+
+// SignRandaoReveal signs the RANDAO reveal for epoch.
+func (s *RemoteSigner) SignRandaoReveal(
+	info crypto.ForkInfo,
+	_, epoch uint64,
+	signingRoot pbytes.B32,
+) (crypto.BLSSignature, error) {
+	return s.sign(signRequest{
+		Type:        typeRandaoReveal,
+		SigningRoot: signingRoot,
+		ForkInfo: &forkInfo{
+			Fork: fork{
+				PreviousVersion: info.PreviousVersion,
+				CurrentVersion:  info.CurrentVersion,
+				Epoch:           strconv.FormatUint(info.Epoch, 10),
+			},
+			GenesisValidatorsRoot: info.GenesisValidatorsRoot,
+		},
+		RandaoReveal: &randaoReveal{
+			Epoch: strconv.FormatUint(epoch, 10),
+		},
+	}, signingRoot)
+}
+
+// SignDeposit signs the deposit message.
+func (s *RemoteSigner) SignDeposit(
+	deposit crypto.DepositInfo,
+	signingRoot pbytes.B32,
+) (crypto.BLSSignature, error) {
+	return s.sign(signRequest{
+		Type:        typeDeposit,
+		SigningRoot: signingRoot,
+		Deposit: &depositData{
+			Pubkey:                deposit.Pubkey,
+			WithdrawalCredentials: deposit.WithdrawalCredentials,
+			Amount:                strconv.FormatUint(deposit.Amount, 10),
+			GenesisForkVersion:    deposit.GenesisForkVersion,
+		},
+	}, signingRoot)
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (RemoteSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if ok := bls12381.PubKey(pubKey[:]).
+		VerifySignature(msg, signature[:]); !ok {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// sign sends req to the remote signer and verifies the returned signature
+// against signingRoot, so a misbehaving signer cannot make us propose
+// garbage.
+func (s *RemoteSigner) sign(
+	req signRequest,
+	signingRoot pbytes.B32,
+) (crypto.BLSSignature, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, s.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return crypto.BLSSignature{}, errors.Wrapf(
+			ErrRemoteSignerRequest, "%s %s: %s",
+			req.Type, resp.Status, strings.TrimSpace(string(respBody)),
+		)
+	}
+
+	var sigResp signResponse
+	if err = json.Unmarshal(respBody, &sigResp); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err = s.VerifySignature(
+		s.pubkey, signingRoot[:], sigResp.Signature,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return sigResp.Signature, nil
+}
+
+// signRequest is the body of a Web3Signer eth2 signing request.
+type signRequest struct {
+	Type         string        `json:"type"`
+	SigningRoot  pbytes.B32    `json:"signingRoot"`
+	ForkInfo     *forkInfo     `json:"fork_info,omitempty"`
+	RandaoReveal *randaoReveal `json:"randao_reveal,omitempty"`
+	Deposit      *depositData  `json:"deposit,omitempty"`
+}
+
+type forkInfo struct {
+	Fork                  fork       `json:"fork"`
+	GenesisValidatorsRoot pbytes.B32 `json:"genesis_validators_root"`
+}
+
+type fork struct {
+	PreviousVersion pbytes.B4 `json:"previous_version"`
+	CurrentVersion  pbytes.B4 `json:"current_version"`
+	Epoch           string    `json:"epoch"`
+}
+
+type randaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+type depositData struct {
+	Pubkey                crypto.BLSPubkey `json:"pubkey"`
+	WithdrawalCredentials pbytes.B32       `json:"withdrawal_credentials"`
+	Amount                string           `json:"amount"`
+	GenesisForkVersion    pbytes.B4        `json:"genesis_fork_version"`
+}
+
+// signResponse is the JSON response of a Web3Signer signing request.
+type signResponse struct {
+	Signature crypto.BLSSignature `json:"signature"`
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// testPKI is a CA with a server and a client certificate, written to dir.
+type testPKI struct {
+	caPath, certPath, keyPath string
+	serverCert                tls.Certificate
+	pool                      *x509.CertPool
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(
+		rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey,
+	)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+		key, kErr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, kErr)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "test"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, kErr := x509.CreateCertificate(
+			rand.Reader, tmpl, caCert, &key.PublicKey, caKey,
+		)
+		require.NoError(t, kErr)
+		keyDER, kErr := x509.MarshalECPrivateKey(key)
+		require.NoError(t, kErr)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	}
+
+	pki := &testPKI{
+		caPath:   filepath.Join(dir, "ca.pem"),
+		certPath: filepath.Join(dir, "client.pem"),
+		keyPath:  filepath.Join(dir, "client.key"),
+		pool:     x509.NewCertPool(),
+	}
+	pki.pool.AddCert(caCert)
+	require.NoError(t, os.WriteFile(pki.caPath, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: caDER},
+	), 0o600))
+
+	clientCert, clientKey := issue(2, x509.ExtKeyUsageClientAuth)
+	require.NoError(t, os.WriteFile(pki.certPath, clientCert, 0o600))
+	require.NoError(t, os.WriteFile(pki.keyPath, clientKey, 0o600))
+
+	serverCert, serverKey := issue(3, x509.ExtKeyUsageServerAuth)
+	pki.serverCert, err = tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	return pki
+}
+
+// newWeb3Signer starts a fake Web3Signer that requires client certificates
+// and signs the signing root of typed requests for pubkey with key.
+func newWeb3Signer(
+	t *testing.T,
+	pki *testPKI,
+	pubkey crypto.BLSPubkey,
+	key *signer.LegacySigner,
+	requests chan<- map[string]any,
+) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/eth2/sign/"+pubkey.String() {
+				http.NotFound(w, r)
+				return
+			}
+			var req map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			requests <- req
+			var root bytes.B32
+			if err := root.UnmarshalText(
+				[]byte(req["signingRoot"].(string)),
+			); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			sig, err := key.Sign(root[:])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp, err := json.Marshal(map[string]any{"signature": sig})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(resp)
+		},
+	))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{pki.serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pki.pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRemoteSigner(t *testing.T) {
+	pki := newTestPKI(t)
+	key, err := signer.NewLegacySigner(signer.LegacyKey{1})
+	require.NoError(t, err)
+	requests := make(chan map[string]any, 1)
+	srv := newWeb3Signer(t, pki, key.PublicKey(), key, requests)
+
+	cfg := signer.DefaultRemoteConfig()
+	cfg.URL = srv.URL
+	cfg.Pubkey = key.PublicKey().String()
+	cfg.CACertPath = pki.caPath
+	cfg.ClientCertPath = pki.certPath
+	cfg.ClientKeyPath = pki.keyPath
+	remote, err := signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey(), remote.PublicKey())
+
+	root := bytes.B32{0xaa}
+	want, err := key.Sign(root[:])
+	require.NoError(t, err)
+
+	t.Run("randao reveal", func(t *testing.T) {
+		sig, sErr := remote.SignRandaoReveal(crypto.ForkInfo{
+			PreviousVersion: bytes.B4{0, 0, 0, 4},
+			CurrentVersion:  bytes.B4{0, 0, 0, 4},
+		}, 7, 7, root)
+		require.NoError(t, sErr)
+		require.Equal(t, want, sig)
+
+		req := <-requests
+		require.Equal(t, "RANDAO_REVEAL", req["type"])
+		require.Equal(t, map[string]any{"epoch": "7"}, req["randao_reveal"])
+		fork := req["fork_info"].(map[string]any)["fork"].(map[string]any)
+		require.Equal(t, "0x00000004", fork["current_version"])
+	})
+
+	t.Run("deposit", func(t *testing.T) {
+		sig, sErr := remote.SignDeposit(crypto.DepositInfo{
+			Pubkey: key.PublicKey(),
+			Amount: 32e9,
+		}, root)
+		require.NoError(t, sErr)
+		require.Equal(t, want, sig)
+
+		req := <-requests
+		require.Equal(t, "DEPOSIT", req["type"])
+		deposit := req["deposit"].(map[string]any)
+		require.Equal(t, "32000000000", deposit["amount"])
+		require.Equal(t, key.PublicKey().String(), deposit["pubkey"])
+	})
+
+	t.Run("opaque messages are refused", func(t *testing.T) {
+		_, sErr := remote.Sign(root[:])
+		require.ErrorIs(t, sErr, signer.ErrUnsupportedSigningRequest)
+	})
+
+	t.Run("client certificate is required", func(t *testing.T) {
+		noCert := cfg
+		noCert.ClientCertPath, noCert.ClientKeyPath = "", ""
+		anon, sErr := signer.NewRemoteSigner(noCert)
+		require.NoError(t, sErr)
+		_, sErr = anon.SignRandaoReveal(crypto.ForkInfo{}, 7, 7, root)
+		require.Error(t, sErr)
+	})
+}
+
+func TestRemoteSigner_RejectsWrongSignature(t *testing.T) {
+	pki := newTestPKI(t)
+	key, err := signer.NewLegacySigner(signer.LegacyKey{1})
+	require.NoError(t, err)
+	other, err := signer.NewLegacySigner(signer.LegacyKey{2})
+	require.NoError(t, err)
+	// The signer answers for key with the signature of another key.
+	srv := newWeb3Signer(
+		t, pki, key.PublicKey(), other, make(chan map[string]any, 1),
+	)
+
+	cfg := signer.DefaultRemoteConfig()
+	cfg.URL = srv.URL
+	cfg.Pubkey = key.PublicKey().String()
+	cfg.CACertPath = pki.caPath
+	cfg.ClientCertPath = pki.certPath
+	cfg.ClientKeyPath = pki.keyPath
+	remote, err := signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+
+	_, err = remote.SignRandaoReveal(crypto.ForkInfo{}, 1, 1, bytes.B32{1})
+	require.Error(t, err)
+}
@@ -20,9 +20,43 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/node-core/components/metrics"
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TelemetrySinkInput is the input for the telemetry sink provider.
+type TelemetrySinkInput struct {
+	depinject.In
+	Config *config.Config
+}
 
 // ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+//
+// With the "prometheus" sink, metrics are registered with the default
+// Prometheus registerer, which is served by the CometBFT instrumentation
+// listener.
+func ProvideTelemetrySink(
+	in TelemetrySinkInput,
+) (*metrics.TelemetrySink, error) {
+	switch in.Config.Metrics.Sink {
+	case metrics.SinkTelemetry, "":
+		return &metrics.TelemetrySink{}, nil
+	case metrics.SinkPrometheus:
+		registry, err := metrics.NewRegistry(
+			prometheus.DefaultRegisterer, metrics.DefaultCatalogue(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		sink := metrics.NewPrometheusTelemetrySink(registry)
+		return &sink, nil
+	default:
+		return nil, errors.Wrapf(
+			metrics.ErrUnknownSink, "%s", in.Config.Metrics.Sink,
+		)
+	}
 }
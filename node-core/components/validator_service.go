@@ -24,10 +24,12 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/da/gossip"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/payload/registration"
 	"github.com/berachain/beacon-kit/primitives/common"
-	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -55,8 +57,10 @@ type ValidatorServiceInput[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
 	StorageBackend StorageBackendT
-	Signer         crypto.BLSSigner
+	Registrations  *registration.Registry
+	Keyring        *signer.Keyring
 	SidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
+	SidecarGossip  *gossip.Reactor
 	TelemetrySink  *metrics.TelemetrySink
 }
 
@@ -104,6 +108,12 @@ func ProvideValidatorService[
 	ExecutionPayloadT, ExecutionPayloadHeaderT,
 	*ForkData, *SlashingInfo, *SlotData,
 ], error) {
+	// Sidecars are only gossiped outside of the proposal when enabled.
+	var sidecarPublisher validator.SidecarPublisher
+	if in.Cfg.SidecarGossip.Enabled {
+		sidecarPublisher = in.SidecarGossip
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
@@ -125,12 +135,14 @@ func ProvideValidatorService[
 		in.ChainSpec,
 		in.StorageBackend,
 		in.StateProcessor,
-		in.Signer,
+		in.Keyring,
 		in.SidecarFactory,
 		in.LocalBuilder,
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		in.Registrations,
+		sidecarPublisher,
 		in.TelemetrySink,
 	), nil
 }
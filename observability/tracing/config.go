@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	// ExporterOTLP exports spans to an OTLP/HTTP collector.
+	ExporterOTLP = "otlp"
+	// ExporterFile writes spans as JSON lines to a local file.
+	ExporterFile = "file"
+
+	defaultExporter    = ExporterOTLP
+	defaultEndpoint    = "localhost:4318"
+	defaultFilePath    = "./traces.json"
+	defaultSampleRatio = 1.0
+	defaultServiceName = "beacond"
+)
+
+// Config is the configuration for request-scoped tracing.
+type Config struct {
+	// Enabled determines if spans are recorded and exported.
+	Enabled bool `mapstructure:"enabled"`
+	// Exporter is the span exporter to use, either "otlp" or "file".
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the host:port of the OTLP/HTTP collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS when talking to the OTLP collector.
+	Insecure bool `mapstructure:"insecure"`
+	// FilePath is the path spans are written to by the file exporter.
+	FilePath string `mapstructure:"file-path"`
+	// SampleRatio is the fraction of root spans that are sampled.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+	// ServiceName is the service name attached to every exported span.
+	ServiceName string `mapstructure:"service-name"`
+}
+
+// DefaultConfig returns the default configuration for tracing.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Exporter:    defaultExporter,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		FilePath:    defaultFilePath,
+		SampleRatio: defaultSampleRatio,
+		ServiceName: defaultServiceName,
+	}
+}
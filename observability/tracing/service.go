@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ErrUnknownExporter is returned when the configured exporter is not
+// supported.
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+// Service owns the tracer provider and flushes it on shutdown.
+type Service struct {
+	// cfg is the tracing configuration.
+	cfg *Config
+	// logger is the logger for the tracing service.
+	logger log.Logger
+	// provider is the SDK tracer provider, nil when tracing is disabled.
+	provider *sdktrace.TracerProvider
+	// file is the output file of the file exporter, if used.
+	file *os.File
+}
+
+// NewService creates a new tracing service.
+func NewService(cfg *Config, logger log.Logger) *Service {
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start creates the configured exporter and installs the tracer provider
+// as the global provider.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	exporter, err := s.newExporter(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(s.cfg.ServiceName),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(s.cfg.SampleRatio),
+		)),
+	)
+	otel.SetTracerProvider(s.provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	s.logger.Info(
+		"Tracing enabled 🔭",
+		"exporter", s.cfg.Exporter,
+		"endpoint", s.cfg.Endpoint,
+		"sample_ratio", s.cfg.SampleRatio,
+	)
+	return nil
+}
+
+// Stop flushes any buffered spans and shuts down the exporter.
+func (s *Service) Stop() error {
+	var errs []error
+	if s.provider != nil {
+		errs = append(errs, s.provider.Shutdown(context.Background()))
+	}
+	if s.file != nil {
+		errs = append(errs, s.file.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// newExporter creates the span exporter selected by the configuration.
+func (s *Service) newExporter(
+	ctx context.Context,
+) (sdktrace.SpanExporter, error) {
+	switch s.cfg.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(s.cfg.Endpoint),
+		}
+		if s.cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case ExporterFile:
+		//#nosec:G304 // the path is provided by the operator.
+		f, err := os.OpenFile(
+			s.cfg.FilePath,
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND,
+			//nolint:mnd // file permissions.
+			0o600,
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.file = f
+		return stdouttrace.New(stdouttrace.WithWriter(f))
+	default:
+		return nil, errors.Wrapf(ErrUnknownExporter, "%s", s.cfg.Exporter)
+	}
+}
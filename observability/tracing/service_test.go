@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_FileExporter(t *testing.T) {
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Exporter = tracing.ExporterFile
+	cfg.FilePath = filepath.Join(t.TempDir(), "traces.json")
+
+	svc := tracing.NewService(&cfg, noop.NewLogger[any]())
+	require.NoError(t, svc.Start(context.Background()))
+
+	ctx, parent := tracing.StartSpan(context.Background(), "parent")
+	_, child := tracing.StartSpan(ctx, "child")
+	tracing.EndSpan(child, errors.New("boom"))
+	tracing.EndSpan(parent, nil)
+
+	// Stop flushes the batcher to the file.
+	require.NoError(t, svc.Stop())
+
+	out, err := os.ReadFile(cfg.FilePath)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"Name":"parent"`)
+	require.Contains(t, string(out), `"Name":"child"`)
+	require.Contains(t, string(out), "boom")
+}
+
+func TestService_Disabled(t *testing.T) {
+	cfg := tracing.DefaultConfig()
+	svc := tracing.NewService(&cfg, noop.NewLogger[any]())
+	require.NoError(t, svc.Start(context.Background()))
+	require.NoError(t, svc.Stop())
+}
+
+func TestService_UnknownExporter(t *testing.T) {
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Exporter = "carrier-pigeon"
+	svc := tracing.NewService(&cfg, noop.NewLogger[any]())
+	require.ErrorIs(
+		t, svc.Start(context.Background()), tracing.ErrUnknownExporter,
+	)
+}
@@ -23,6 +23,7 @@ package attributes
 import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -36,9 +37,9 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// feeRecipients holds the fee recipient registered for each proposer,
+	// sent to the execution client for the payload build.
+	feeRecipients FeeRecipientRegistry
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -49,15 +50,27 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger,
-	suggestedFeeRecipient common.ExecutionAddress,
+	feeRecipients FeeRecipientRegistry,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:     chainSpec,
+		logger:        logger,
+		feeRecipients: feeRecipients,
 	}
 }
 
+// SuggestedFeeRecipient returns the fee recipient registered for the
+// proposer, or the configured default if there is none.
+func (f *Factory[
+	BeaconStateT,
+	PayloadAttributesT,
+	WithdrawalT,
+]) SuggestedFeeRecipient(
+	proposer crypto.BLSPubkey,
+) common.ExecutionAddress {
+	return f.feeRecipients.FeeRecipient(proposer)
+}
+
 // BuildPayloadAttributes creates a new instance of PayloadAttributes.
 func (f *Factory[
 	BeaconStateT,
@@ -68,6 +81,7 @@ func (f *Factory[
 	slot math.Slot,
 	timestamp uint64,
 	prevHeadRoot [32]byte,
+	proposer crypto.BLSPubkey,
 ) (PayloadAttributesT, error) {
 	var (
 		prevRandao [32]byte
@@ -97,7 +111,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.SuggestedFeeRecipient(proposer),
 		withdrawals,
 		prevHeadRoot,
 	)
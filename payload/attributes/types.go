@@ -23,6 +23,7 @@ package attributes
 import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 // BeaconState is an interface for accessing the beacon state.
@@ -33,6 +34,14 @@ type BeaconState[WithdrawalT any] interface {
 	GetRandaoMixAtIndex(index uint64) (common.Bytes32, error)
 }
 
+// FeeRecipientRegistry is the interface for the registry of the fee
+// recipients of the validators hosted by the node.
+type FeeRecipientRegistry interface {
+	// FeeRecipient returns the fee recipient registered for the validator
+	// with the given pubkey, falling back to the node default.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
 // PayloadAttributes is the interface for the payload attributes.
 type PayloadAttributes[SelfT any, WithdrawalT any] interface {
 	engineprimitives.PayloadAttributer
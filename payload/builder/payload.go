@@ -26,6 +26,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -42,6 +43,7 @@ func (pb *PayloadBuilder[
 	parentBlockRoot common.Root,
 	headEth1BlockHash common.ExecutionHash,
 	finalEth1BlockHash common.ExecutionHash,
+	proposer crypto.BLSPubkey,
 ) (*PayloadIDT, error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -60,7 +62,7 @@ func (pb *PayloadBuilder[
 
 	// Assemble the payload attributes.
 	attrs, err := pb.attributesFactory.
-		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
+		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot, proposer)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +105,7 @@ func (pb *PayloadBuilder[
 	parentBlockRoot common.Root,
 	parentEth1Hash common.ExecutionHash,
 	finalBlockHash common.ExecutionHash,
+	proposer crypto.BLSPubkey,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -118,6 +121,7 @@ func (pb *PayloadBuilder[
 		parentBlockRoot,
 		parentEth1Hash,
 		finalBlockHash,
+		proposer,
 	)
 	if err != nil {
 		return nil, err
@@ -155,6 +159,7 @@ func (pb *PayloadBuilder[
 	ctx context.Context,
 	slot math.Slot,
 	parentBlockRoot common.Root,
+	proposer crypto.BLSPubkey,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -196,12 +201,13 @@ func (pb *PayloadBuilder[
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	suggestedFeeRecipient := pb.attributesFactory.SuggestedFeeRecipient(proposer)
+	if payload.GetFeeRecipient() != suggestedFeeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", suggestedFeeRecipient,
 		)
 	}
 	return envelope, err
@@ -88,7 +88,11 @@ type AttributesFactory[
 		slot math.U64,
 		timestamp uint64,
 		prevHeadRoot [32]byte,
+		proposer crypto.BLSPubkey,
 	) (PayloadAttributesT, error)
+	// SuggestedFeeRecipient returns the fee recipient of the payloads built
+	// for proposer.
+	SuggestedFeeRecipient(proposer crypto.BLSPubkey) common.ExecutionAddress
 }
 
 // PayloadAttributes is the interface for the payload attributes.
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registration
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// DB is the key-value store the registrations are persisted to.
+type DB interface {
+	// Get retrieves the value for a key.
+	Get(key []byte) ([]byte, error)
+	// Set stores the value for a key.
+	Set(key []byte, value []byte) error
+}
+
+// record is the registration of a validator, persisted as a whole so that
+// its fields are always updated together.
+type record struct {
+	// FeeRecipient is the fee recipient of the validator.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the target gas limit of the validator, nil if it did not
+	// register one.
+	GasLimit *uint64 `json:"gas_limit,omitempty"`
+	// Timestamp is the timestamp of the last signed registration, zero if
+	// the fee recipient was changed without one since.
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// Registry keeps track of the payload preferences registered for each
+// validator hosted by the node, keyed by the validator pubkey. Validators
+// without a registration use the node defaults.
+type Registry struct {
+	// db is the store the registrations are persisted to.
+	db DB
+	// logger is the logger for the registry.
+	logger log.Logger
+	// defaultFeeRecipient is returned for validators that did not register
+	// a fee recipient.
+	defaultFeeRecipient common.ExecutionAddress
+	// defaultGasLimit is returned for validators that did not register a
+	// target gas limit.
+	defaultGasLimit uint64
+}
+
+// NewRegistry creates a new registry.
+func NewRegistry(
+	db DB,
+	logger log.Logger,
+	defaultFeeRecipient common.ExecutionAddress,
+	defaultGasLimit uint64,
+) *Registry {
+	return &Registry{
+		db:                  db,
+		logger:              logger,
+		defaultFeeRecipient: defaultFeeRecipient,
+		defaultGasLimit:     defaultGasLimit,
+	}
+}
+
+// SetFeeRecipient persists the fee recipient of the validator with the given
+// pubkey, keeping its target gas limit. As it is not signed, a change of fee
+// recipient clears the timestamp of the last registration, so that the next
+// signed registration of the validator replaces it.
+func (r *Registry) SetFeeRecipient(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+) error {
+	rec, ok := r.get(pubkey)
+	if ok && rec.FeeRecipient == feeRecipient {
+		return nil
+	}
+	rec.FeeRecipient = feeRecipient
+	rec.Timestamp = 0
+	if err := r.set(pubkey, rec); err != nil {
+		return errors.Wrapf(err, "failed to register fee recipient")
+	}
+	return nil
+}
+
+// FeeRecipient returns the fee recipient registered for the validator with
+// the given pubkey, or the default fee recipient if there is none.
+func (r *Registry) FeeRecipient(
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	rec, ok := r.get(pubkey)
+	if !ok {
+		return r.defaultFeeRecipient
+	}
+	return rec.FeeRecipient
+}
+
+// GasLimit returns the target gas limit registered for the validator with the
+// given pubkey, or the default target gas limit if there is none. Zero means
+// that the validator has no preference.
+func (r *Registry) GasLimit(pubkey crypto.BLSPubkey) uint64 {
+	rec, ok := r.get(pubkey)
+	if !ok || rec.GasLimit == nil {
+		return r.defaultGasLimit
+	}
+	return *rec.GasLimit
+}
+
+// VerifyRegistration ensures that a validator registration is newer than the
+// last one of the same validator. Registrations are re-sent every epoch, so
+// the last registration is accepted again as long as it is unchanged.
+func (r *Registry) VerifyRegistration(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+	gasLimit uint64,
+	timestamp uint64,
+) error {
+	rec, ok := r.get(pubkey)
+	if !ok {
+		return nil
+	}
+	switch {
+	case timestamp > rec.Timestamp:
+		return nil
+	case timestamp == rec.Timestamp &&
+		feeRecipient == rec.FeeRecipient &&
+		rec.GasLimit != nil && gasLimit == *rec.GasLimit:
+		return nil
+	default:
+		return errors.Wrapf(
+			ErrStaleRegistration, "timestamp %d, last registered %d",
+			timestamp, rec.Timestamp,
+		)
+	}
+}
+
+// Register persists the fee recipient and the target gas limit of a
+// validator registration, provided it is newer than the last one of the
+// same validator. Both are persisted along with the timestamp as a single
+// record.
+func (r *Registry) Register(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+	gasLimit uint64,
+	timestamp uint64,
+) error {
+	if err := r.VerifyRegistration(
+		pubkey, feeRecipient, gasLimit, timestamp,
+	); err != nil {
+		return err
+	}
+	if rec, ok := r.get(pubkey); ok && rec.Timestamp == timestamp {
+		// The last registration, sent again.
+		return nil
+	}
+	if err := r.set(pubkey, record{
+		FeeRecipient: feeRecipient,
+		GasLimit:     &gasLimit,
+		Timestamp:    timestamp,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to register validator")
+	}
+	return nil
+}
+
+// get reads the record registered for pubkey, reporting whether there is a
+// valid one.
+func (r *Registry) get(pubkey crypto.BLSPubkey) (record, bool) {
+	var rec record
+	bz, err := r.db.Get(keyFor(pubkey))
+	if errors.Is(err, os.ErrNotExist) {
+		return rec, false
+	}
+	if err == nil {
+		err = json.Unmarshal(bz, &rec)
+	}
+	if err != nil {
+		r.logger.Warn(
+			"Failed to read validator registration, using defaults",
+			"pubkey", pubkey, "error", err,
+		)
+		return record{}, false
+	}
+	return rec, true
+}
+
+// set persists the record of pubkey, replacing the previous one at once.
+func (r *Registry) set(pubkey crypto.BLSPubkey, rec record) error {
+	bz, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(keyFor(pubkey), bz)
+}
+
+// keyFor returns the store key of the given pubkey.
+func keyFor(pubkey crypto.BLSPubkey) []byte {
+	return []byte(pubkey.String())
+}
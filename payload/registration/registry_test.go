@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registration_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/payload/registration"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistry(
+	t *testing.T,
+	dir string,
+	defaultFeeRecipient common.ExecutionAddress,
+	defaultGasLimit uint64,
+) *registration.Registry {
+	t.Helper()
+	logger := noop.NewLogger[any]()
+	return registration.NewRegistry(
+		filedb.NewDB(
+			filedb.WithRootDirectory(dir),
+			filedb.WithFileExtension("json"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(logger),
+		),
+		logger,
+		defaultFeeRecipient, defaultGasLimit,
+	)
+}
+
+func TestRegistryFeeRecipient(t *testing.T) {
+	var (
+		dir          = t.TempDir()
+		defaultAddr  = common.ExecutionAddress{0x01}
+		pubkey       = crypto.BLSPubkey{0xaa}
+		otherPubkey  = crypto.BLSPubkey{0xbb}
+		feeRecipient = common.ExecutionAddress{0x02}
+	)
+	registry := newRegistry(t, dir, defaultAddr, 0)
+
+	// Unregistered validators use the default fee recipient.
+	require.Equal(t, defaultAddr, registry.FeeRecipient(pubkey))
+
+	require.NoError(t, registry.SetFeeRecipient(pubkey, feeRecipient))
+	require.Equal(t, feeRecipient, registry.FeeRecipient(pubkey))
+	require.Equal(t, defaultAddr, registry.FeeRecipient(otherPubkey))
+
+	// Registrations can be updated.
+	updated := common.ExecutionAddress{0x03}
+	require.NoError(t, registry.SetFeeRecipient(pubkey, updated))
+	require.Equal(t, updated, registry.FeeRecipient(pubkey))
+
+	// Registrations survive a restart, while the default is taken from the
+	// current configuration.
+	newDefault := common.ExecutionAddress{0x04}
+	registry = newRegistry(t, dir, newDefault, 0)
+	require.Equal(t, updated, registry.FeeRecipient(pubkey))
+	require.Equal(t, newDefault, registry.FeeRecipient(otherPubkey))
+}
+
+func TestRegistryGasLimit(t *testing.T) {
+	var (
+		dir         = t.TempDir()
+		pubkey      = crypto.BLSPubkey{0xaa}
+		otherPubkey = crypto.BLSPubkey{0xbb}
+	)
+	registry := newRegistry(t, dir, common.ExecutionAddress{}, 30_000_000)
+
+	// Unregistered validators use the default gas limit.
+	require.Equal(t, uint64(30_000_000), registry.GasLimit(pubkey))
+
+	require.NoError(t, registry.Register(
+		pubkey, common.ExecutionAddress{}, 36_000_000, 10,
+	))
+	require.Equal(t, uint64(36_000_000), registry.GasLimit(pubkey))
+	require.Equal(t, uint64(30_000_000), registry.GasLimit(otherPubkey))
+
+	// Setting the fee recipient keeps the registered gas limit, while a
+	// validator that only set its fee recipient uses the default one.
+	require.NoError(t, registry.SetFeeRecipient(
+		pubkey, common.ExecutionAddress{0x02},
+	))
+	require.NoError(t, registry.SetFeeRecipient(
+		otherPubkey, common.ExecutionAddress{0x02},
+	))
+	require.Equal(t, uint64(36_000_000), registry.GasLimit(pubkey))
+	require.Equal(t, uint64(30_000_000), registry.GasLimit(otherPubkey))
+
+	// Registrations survive a restart.
+	registry = newRegistry(t, dir, common.ExecutionAddress{}, 0)
+	require.Equal(t, uint64(36_000_000), registry.GasLimit(pubkey))
+	require.Zero(t, registry.GasLimit(otherPubkey))
+}
+
+func TestRegistryRegister(t *testing.T) {
+	var (
+		dir          = t.TempDir()
+		pubkey       = crypto.BLSPubkey{0xaa}
+		feeRecipient = common.ExecutionAddress{0x02}
+	)
+	registry := newRegistry(t, dir, common.ExecutionAddress{}, 0)
+
+	require.NoError(t, registry.Register(pubkey, feeRecipient, 36_000_000, 10))
+	require.Equal(t, feeRecipient, registry.FeeRecipient(pubkey))
+	require.Equal(t, uint64(36_000_000), registry.GasLimit(pubkey))
+
+	// The last registration can be sent again unchanged.
+	require.NoError(t, registry.Register(pubkey, feeRecipient, 36_000_000, 10))
+
+	// Registrations which are not newer are rejected, even after a restart.
+	registry = newRegistry(t, dir, common.ExecutionAddress{}, 0)
+	updated := common.ExecutionAddress{0x03}
+	require.ErrorIs(t,
+		registry.Register(pubkey, updated, 36_000_000, 10),
+		registration.ErrStaleRegistration,
+	)
+	require.ErrorIs(t,
+		registry.Register(pubkey, updated, 36_000_000, 9),
+		registration.ErrStaleRegistration,
+	)
+	require.Equal(t, feeRecipient, registry.FeeRecipient(pubkey))
+
+	require.NoError(t, registry.Register(pubkey, updated, 30_000_000, 11))
+	require.Equal(t, updated, registry.FeeRecipient(pubkey))
+	require.Equal(t, uint64(30_000_000), registry.GasLimit(pubkey))
+}
+
+func TestRegistrySetFeeRecipientAfterRegister(t *testing.T) {
+	var (
+		pubkey     = crypto.BLSPubkey{0xaa}
+		registered = common.ExecutionAddress{0x02}
+		prepared   = common.ExecutionAddress{0x03}
+	)
+	registry := newRegistry(t, t.TempDir(), common.ExecutionAddress{}, 0)
+	require.NoError(t, registry.Register(pubkey, registered, 36_000_000, 10))
+
+	// Preparing the registered fee recipient keeps the registration, so
+	// that older registrations are still rejected.
+	require.NoError(t, registry.SetFeeRecipient(pubkey, registered))
+	require.ErrorIs(t,
+		registry.Register(pubkey, prepared, 36_000_000, 9),
+		registration.ErrStaleRegistration,
+	)
+
+	// Preparing another fee recipient replaces the registered one until the
+	// registration is sent again.
+	require.NoError(t, registry.SetFeeRecipient(pubkey, prepared))
+	require.Equal(t, prepared, registry.FeeRecipient(pubkey))
+	require.NoError(t, registry.Register(pubkey, registered, 36_000_000, 10))
+	require.Equal(t, registered, registry.FeeRecipient(pubkey))
+	require.Equal(t, uint64(36_000_000), registry.GasLimit(pubkey))
+}
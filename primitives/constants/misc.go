@@ -29,4 +29,10 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// UnsetDepositRequestsStartIndex is the deposit requests start index
+	// before the first EIP-6110 deposit request is processed.
+	UnsetDepositRequestsStartIndex = ^uint64(0)
+	// PendingDepositsLimit is the maximum number of deposit requests waiting
+	// in the beacon state for the legacy deposits to be processed.
+	PendingDepositsLimit uint64 = 134217728
 )
@@ -63,3 +63,49 @@ type BLSSigner interface {
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
 }
+
+// ForkInfo is the fork a message is signed for.
+type ForkInfo struct {
+	// PreviousVersion is the fork version before Epoch.
+	PreviousVersion bytes.B4
+	// CurrentVersion is the fork version from Epoch onwards.
+	CurrentVersion bytes.B4
+	// Epoch is the epoch at which CurrentVersion activated.
+	Epoch uint64
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot bytes.B32
+}
+
+// DepositInfo is the deposit message being signed.
+type DepositInfo struct {
+	// Pubkey is the public key of the validator.
+	Pubkey BLSPubkey
+	// WithdrawalCredentials are the withdrawal credentials of the deposit.
+	WithdrawalCredentials bytes.B32
+	// Amount is the deposit amount, in Gwei.
+	Amount uint64
+	// GenesisForkVersion is the fork version the deposit domain is computed
+	// with.
+	GenesisForkVersion bytes.B4
+}
+
+// RandaoRevealSigner is implemented by BLSSigners that must know they are
+// signing a RANDAO reveal rather than an opaque signing root, such as remote
+// signers enforcing their own slashing protection.
+type RandaoRevealSigner interface {
+	// SignRandaoReveal signs the RANDAO reveal for epoch, whose signing root
+	// is signingRoot, for the block proposed at slot.
+	SignRandaoReveal(
+		fork ForkInfo, slot, epoch uint64, signingRoot bytes.B32,
+	) (BLSSignature, error)
+}
+
+// DepositSigner is implemented by BLSSigners that must know they are signing
+// a deposit message rather than an opaque signing root.
+type DepositSigner interface {
+	// SignDeposit signs the deposit message, whose signing root is
+	// signingRoot.
+	SignDeposit(
+		deposit DepositInfo, signingRoot bytes.B32,
+	) (BLSSignature, error)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip7594
+
+import (
+	"unsafe"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// FieldElementsPerCell is the number of field elements in a cell.
+	FieldElementsPerCell = 64
+	// BytesPerCell is the size of a cell in bytes.
+	BytesPerCell = FieldElementsPerCell * 32
+	// CellsPerExtBlob is the number of cells in a blob extended with its
+	// erasure coding.
+	CellsPerExtBlob = 128
+	// NumberOfColumns is the number of columns of the extended data matrix,
+	// a column holding the cell with the same index of every blob.
+	NumberOfColumns = CellsPerExtBlob
+	// NumberOfCustodyGroups is the number of groups columns are custodied
+	// in, each group holding a single column.
+	NumberOfCustodyGroups = NumberOfColumns
+	// CustodyRequirement is the minimum number of custody groups a node
+	// custodies.
+	CustodyRequirement = 4
+)
+
+// Cell represents an EIP-7594 cell, a contiguous chunk of an extended blob.
+type Cell [BytesPerCell]byte
+
+// UnmarshalJSON parses a cell in hex syntax.
+func (c *Cell) UnmarshalJSON(input []byte) error {
+	return bytes.UnmarshalFixedJSON(input, c[:])
+}
+
+// MarshalText returns the hex representation of c.
+func (c Cell) MarshalText() ([]byte, error) {
+	return bytes.Bytes(c[:]).MarshalText()
+}
+
+// SizeSSZ returns the size of the cell in SSZ encoding.
+func (c *Cell) SizeSSZ(*ssz.Sizer) uint32 {
+	return BytesPerCell
+}
+
+// DefineSSZ defines the SSZ encoding of the cell. A cell is encoded and
+// hashed the same as a vector of 32 byte chunks.
+func (c *Cell) DefineSSZ(codec *ssz.Codec) {
+	//#nosec:G103 // a cell is exactly FieldElementsPerCell chunks.
+	chunks := (*[FieldElementsPerCell][32]byte)(unsafe.Pointer(c))
+	ssz.DefineUnsafeArrayOfStaticBytes(codec, chunks[:])
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the cell.
+func (c *Cell) HashTreeRoot() [32]byte {
+	return ssz.HashSequential(c)
+}
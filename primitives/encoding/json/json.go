@@ -39,6 +39,12 @@ var MarshalIndent = json.MarshalIndent
 
 var Unmarshal = json.Unmarshal
 
+var NewDecoder = json.NewDecoder
+
+// Decoder is an alias for json.Decoder, which reads and decodes JSON values
+// from an input stream.
+type Decoder = json.Decoder
+
 // RawMessage is an alias for json.RawMessage, represensting a raw encoded JSON
 // value. It implements Marshaler and Unmarshaler and can be used to delay JSON
 // decoding or precompute a JSON encoding.
@@ -232,6 +232,7 @@ func setupState(
 	)
 
 	ctx := &transition.Context{
+		Context:                 context.Background(),
 		SkipPayloadVerification: true,
 		SkipValidateResult:      true,
 		ProposerAddress:         dummyProposerAddr,
@@ -263,6 +264,19 @@ func progressStateToSlot(
 	require.NoError(t, err)
 }
 
+// nextEVMInflationWithdrawal returns the EVM inflation withdrawal expected in
+// the block built on top of the latest block header of the given state.
+func nextEVMInflationWithdrawal(
+	t *testing.T,
+	beaconState *TestBeaconStateT,
+) *engineprimitives.Withdrawal {
+	t.Helper()
+
+	parentBlkHeader, err := beaconState.GetLatestBlockHeader()
+	require.NoError(t, err)
+	return beaconState.EVMInflationWithdrawal(parentBlkHeader.GetSlot() + 1)
+}
+
 func buildNextBlock(
 	t *testing.T,
 	beaconState *TestBeaconStateT,
@@ -66,6 +66,12 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrExceedsPendingDepositsLimit is returned when the deposit requests
+	// of a block overflow the pending deposits of the state.
+	ErrExceedsPendingDepositsLimit = errors.New(
+		"deposit requests exceed pending deposits limit",
+	)
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -109,4 +115,8 @@ var (
 	// not match the local state's expected value.
 	ErrWithdrawalMismatch = errors.New(
 		"withdrawal mismatch between local state and payload")
+
+	// ErrUnsupportedForkUpgrade is returned when the chain spec schedules a
+	// fork version that the state cannot be upgraded to.
+	ErrUnsupportedForkUpgrade = errors.New("unsupported fork upgrade")
 )
@@ -160,6 +160,8 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(*ctypes.Eth1Data) error
 	SetEth1DepositIndex(uint64) error
+	SetDepositRequestsStartIndex(uint64) error
+	SetPendingDeposits([]*ctypes.Deposit) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -169,6 +171,8 @@ type WriteOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (*ctypes.Eth1Data, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetDepositRequestsStartIndex() (uint64, error)
+	GetPendingDeposits() ([]*ctypes.Deposit, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
@@ -176,6 +180,6 @@ type ReadOnlyEth1Data[ExecutionPayloadHeaderT any] interface {
 
 // ReadOnlyWithdrawals only has read access to withdrawal methods.
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
-	EVMInflationWithdrawal() WithdrawalT
+	EVMInflationWithdrawal(slot math.Slot) WithdrawalT
 	ExpectedWithdrawals() ([]WithdrawalT, error)
 }
@@ -58,6 +58,18 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	// GetDepositRequestsStartIndex retrieves the index of the first
+	// EIP-6110 deposit request.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// SetDepositRequestsStartIndex sets the index of the first EIP-6110
+	// deposit request.
+	SetDepositRequestsStartIndex(index uint64) error
+	// GetPendingDeposits retrieves the EIP-6110 deposit requests waiting
+	// for the legacy deposits to be processed.
+	GetPendingDeposits() ([]*ctypes.Deposit, error)
+	// SetPendingDeposits sets the EIP-6110 deposit requests waiting for
+	// the legacy deposits to be processed.
+	SetPendingDeposits(deposits []*ctypes.Deposit) error
 	// GetBalance retrieves the balance of a validator.
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	// SetBalance sets the balance of a validator.
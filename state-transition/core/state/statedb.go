@@ -216,7 +216,7 @@ func (s *StateDB[
 
 	default:
 		// The first withdrawal is fixed to be the EVM inflation withdrawal.
-		withdrawals = append(withdrawals, s.EVMInflationWithdrawal())
+		withdrawals = append(withdrawals, s.EVMInflationWithdrawal(slot))
 	}
 
 	epoch := math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
@@ -253,6 +253,9 @@ func (s *StateDB[
 		if err != nil {
 			return nil, err
 		}
+		maxEffectiveBalance := MaxEffectiveBalance(
+			s.cs, validator.HasCompoundingWithdrawalCredential(), slot,
+		)
 
 		// Set the amount of the withdrawal depending on the balance of the
 		// validator.
@@ -274,9 +277,7 @@ func (s *StateDB[
 			// Increment the withdrawal index to process the next withdrawal.
 			withdrawalIndex++
 		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance(
-				IsPostFork3(s.cs.DepositEth1ChainID(), slot),
-			)),
+			balance, maxEffectiveBalance,
 		) {
 			withdrawalAddress, err = validator.
 				GetWithdrawalCredentials().ToExecutionAddress()
@@ -288,9 +289,7 @@ func (s *StateDB[
 				math.U64(withdrawalIndex),
 				validatorIndex,
 				withdrawalAddress,
-				balance-math.Gwei(s.cs.MaxEffectiveBalance(
-					IsPostFork3(s.cs.DepositEth1ChainID(), slot),
-				)),
+				balance-maxEffectiveBalance,
 			))
 
 			// Increment the withdrawal index to process the next withdrawal.
@@ -330,19 +329,20 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
-// EVMInflationWithdrawal returns the withdrawal used for EVM balance inflation.
+// EVMInflationWithdrawal returns the withdrawal used for EVM balance inflation
+// at the given slot, following the chain spec EVM inflation schedule.
 //
 // NOTE: The withdrawal index and validator index are both set to 0 as they are
 // not used during processing.
 func (s *StateDB[
 	_, _, _, _, _, _, WithdrawalT, _,
-]) EVMInflationWithdrawal() WithdrawalT {
+]) EVMInflationWithdrawal(slot math.Slot) WithdrawalT {
 	var withdrawal WithdrawalT
 	return withdrawal.New(
 		EVMInflationWithdrawalIndex,
 		EVMInflationWithdrawalValidatorIndex,
-		s.cs.EVMInflationAddress(),
-		math.Gwei(s.cs.EVMInflationPerBlock()),
+		s.cs.EVMInflationAddress(slot),
+		math.Gwei(s.cs.EVMInflationPerBlock(slot)),
 	)
 }
 
@@ -443,6 +443,16 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	depositRequestsStartIndex, err := s.GetDepositRequestsStartIndex()
+	if err != nil {
+		return empty, err
+	}
+
+	pendingDeposits, err := s.GetPendingDeposits()
+	if err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -462,6 +472,8 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		depositRequestsStartIndex,
+		pendingDeposits,
 	)
 }
 
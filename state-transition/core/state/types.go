@@ -54,6 +54,8 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []math.U64, totalSlashing math.U64,
+		depositRequestsStartIndex uint64,
+		pendingDeposits []*ctypes.Deposit,
 	) (T, error)
 }
 
@@ -70,6 +72,9 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// IsPartiallyWithdrawable checks if the validator is partially withdrawable
 	// given two Gwei amounts.
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
+	// HasCompoundingWithdrawalCredential returns true if the validator has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredential() bool
 }
 
 // Withdrawal represents an interface for a withdrawal.
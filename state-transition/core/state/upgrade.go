@@ -22,6 +22,7 @@ package state
 
 import (
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -60,3 +61,18 @@ func IsPostFork3(chainID uint64, slot math.Slot) bool {
 		return true
 	}
 }
+
+// MaxEffectiveBalance returns the max effective balance of a validator at the
+// given slot, depending on whether its withdrawal credentials are compounding.
+func MaxEffectiveBalance(
+	cs common.ChainSpec,
+	isCompounding bool,
+	slot math.Slot,
+) math.Gwei {
+	if isCompounding {
+		return math.Gwei(cs.MaxEffectiveBalanceCompounding())
+	}
+	return math.Gwei(cs.MaxEffectiveBalance(
+		IsPostFork3(cs.DepositEth1ChainID(), slot),
+	))
+}
@@ -22,12 +22,14 @@ package core
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -59,6 +61,7 @@ type StateProcessor[
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT interface {
 		New(common.Version, common.Version, math.Epoch) ForkT
+		GetCurrentVersion() common.Version
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
@@ -119,6 +122,7 @@ func NewStateProcessor[
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT interface {
 		New(common.Version, common.Version, math.Epoch) ForkT
+		GetCurrentVersion() common.Version
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
@@ -180,7 +184,12 @@ func (sp *StateProcessor[
 	}
 
 	// Process the slots.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	var validatorUpdates transition.ValidatorUpdates
+	err := traceStage(ctx, "ProcessSlots", func() error {
+		var slotsErr error
+		validatorUpdates, slotsErr = sp.ProcessSlots(st, blk.GetSlot())
+		return slotsErr
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -248,6 +257,15 @@ func (sp *StateProcessor[
 		if err = st.SetSlot(stateSlot + 1); err != nil {
 			return nil, err
 		}
+
+		// Upgrade the state if a new fork activates with the next epoch.
+		if boundary {
+			if err = sp.processForkUpgrade(
+				st, sp.cs.SlotToEpoch(stateSlot+1),
+			); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return res, nil
@@ -304,23 +322,33 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
-	if err := sp.processBlockHeader(ctx, st, blk); err != nil {
+	if err := traceStage(ctx, "processBlockHeader", func() error {
+		return sp.processBlockHeader(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processExecutionPayload(ctx, st, blk); err != nil {
+	if err := traceStage(ctx, "processExecutionPayload", func() error {
+		return sp.processExecutionPayload(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processWithdrawals(st, blk); err != nil {
+	if err := traceStage(ctx, "processWithdrawals", func() error {
+		return sp.processWithdrawals(st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processRandaoReveal(ctx, st, blk); err != nil {
+	if err := traceStage(ctx, "processRandaoReveal", func() error {
+		return sp.processRandaoReveal(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := traceStage(ctx, "processOperations", func() error {
+		return sp.processOperations(st, blk)
+	}); err != nil {
 		return err
 	}
 
@@ -332,7 +360,9 @@ func (sp *StateProcessor[
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
+	_, span := tracing.StartSpan(ctx, "StateProcessor.HashTreeRoot")
 	stateRoot := st.HashTreeRoot()
+	span.End()
 	if blk.GetStateRoot() != stateRoot {
 		return errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
@@ -519,9 +549,9 @@ func (sp *StateProcessor[
 			updatedBalance := ctypes.ComputeEffectiveBalance(
 				balance,
 				math.U64(sp.cs.EffectiveBalanceIncrement()),
-				math.U64(sp.cs.MaxEffectiveBalance(
-					state.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
-				)),
+				state.MaxEffectiveBalance(
+					sp.cs, val.HasCompoundingWithdrawalCredential(), slot,
+				),
 			)
 			val.SetEffectiveBalance(updatedBalance)
 			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
@@ -531,3 +561,12 @@ func (sp *StateProcessor[
 	}
 	return nil
 }
+
+// traceStage runs a state transition stage inside a span that is a child of
+// the span carried by ctx, if any.
+func traceStage(ctx context.Context, stage string, fn func() error) error {
+	_, span := tracing.StartSpan(ctx, "StateProcessor."+stage)
+	err := fn()
+	tracing.EndSpan(span, err)
+	return err
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// processConsolidationRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_consolidation_request
+// Requests are not validated by the execution client, so invalid ones are
+// ignored rather than failing the block.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processConsolidationRequest(
+	st BeaconStateT,
+	req *ctypes.ConsolidationRequest,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	sourceIdx, err := st.ValidatorIndexByPubkey(req.SourcePubkey)
+	if err != nil {
+		// TODO: improve error handling by distinguishing
+		// ErrNotFound from other kind of errors
+		sp.logger.Info(
+			"ignoring consolidation request with unknown source",
+			"source_pubkey", req.SourcePubkey.String(),
+		)
+		return nil
+	}
+	source, err := st.ValidatorByIndex(sourceIdx)
+	if err != nil {
+		return err
+	}
+
+	// The request must be sent by the withdrawal address of the source.
+	credentials := source.GetWithdrawalCredentials()
+	if !source.HasExecutionWithdrawalCredential() ||
+		common.ExecutionAddress(credentials[12:]) != req.SourceAddress {
+		sp.logger.Info(
+			"ignoring consolidation request with mismatching source address",
+			"source_index", sourceIdx,
+		)
+		return nil
+	}
+
+	// A request with the same source and target switches the validator to
+	// compounding withdrawal credentials.
+	if req.SourcePubkey == req.TargetPubkey {
+		if source.HasCompoundingWithdrawalCredential() {
+			return nil
+		}
+		source.SwitchToCompoundingWithdrawalCredential()
+		if err = st.UpdateValidatorAtIndex(sourceIdx, source); err != nil {
+			return err
+		}
+		sp.logger.Info(
+			"Switched validator to compounding withdrawal credentials",
+			"validator_index", sourceIdx,
+		)
+		return nil
+	}
+
+	targetIdx, err := st.ValidatorIndexByPubkey(req.TargetPubkey)
+	if err != nil {
+		sp.logger.Info(
+			"ignoring consolidation request with unknown target",
+			"target_pubkey", req.TargetPubkey.String(),
+		)
+		return nil
+	}
+	target, err := st.ValidatorByIndex(targetIdx)
+	if err != nil {
+		return err
+	}
+
+	// Only compounding validators can receive the consolidated stake, and
+	// both validators must be active and not exiting.
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if !target.HasCompoundingWithdrawalCredential() ||
+		!source.IsActive(epoch) || !target.IsActive(epoch) ||
+		source.GetExitEpoch() != farFutureEpoch ||
+		target.GetExitEpoch() != farFutureEpoch {
+		sp.logger.Info(
+			"ignoring invalid consolidation request",
+			"source_index", sourceIdx, "target_index", targetIdx,
+		)
+		return nil
+	}
+
+	// The source validator joins the exit queue, while its balance is moved
+	// to the target right away. Effective balances follow at the end of the
+	// epoch. Unlike Electra specs there is no pending consolidations queue
+	// nor consolidation churn, see README.md.
+	if err = sp.initiateValidatorExit(st, sourceIdx, source); err != nil {
+		return err
+	}
+
+	balance, err := st.GetBalance(sourceIdx)
+	if err != nil {
+		return err
+	}
+	if err = st.DecreaseBalance(sourceIdx, balance); err != nil {
+		return err
+	}
+	if err = st.IncreaseBalance(targetIdx, balance); err != nil {
+		return err
+	}
+
+	sp.logger.Info(
+		"Processed consolidation request",
+		"source_index", sourceIdx,
+		"target_index", targetIdx,
+		"amount", float64(balance.Unwrap())/math.GweiPerWei,
+	)
+	return nil
+}
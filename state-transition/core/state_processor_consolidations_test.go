@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionConsolidationRequests shows that consolidation requests move
+// the stake of the source validator to a compounding target, which can then
+// hold an effective balance above the non compounding max effective balance.
+func TestTransitionConsolidationRequests(t *testing.T) {
+	specData := spec.BaseSpec()
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	sp, st, _, ctx := setupState(t, cs)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		addressX   = common.ExecutionAddress{0x0a}
+		addressY   = common.ExecutionAddress{0x0b}
+	)
+
+	// STEP 0: Setup initial state via genesis
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey: [48]byte{0x00},
+			Credentials: types.NewCompoundingCredentialsFromExecutionAddress(
+				addressX,
+			),
+			Amount: maxBalance,
+			Index:  0,
+		},
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(addressX),
+			Amount:      maxBalance,
+			Index:       1,
+		},
+		{
+			Pubkey:      [48]byte{0x02},
+			Credentials: types.NewCredentialsFromExecutionAddress(addressY),
+			Amount:      maxBalance,
+			Index:       2,
+		},
+	}
+	genVals, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+	require.Len(t, genVals, len(genDeposits))
+
+	// STEP 1: process a consolidation with a mismatching source address, a
+	// valid consolidation and a switch to compounding credentials.
+	body := buildElectraBlockBody(t, st, 10)
+	body.ExecutionRequests.Consolidations = []*types.ConsolidationRequest{
+		{
+			SourceAddress: addressY,
+			SourcePubkey:  genDeposits[1].Pubkey,
+			TargetPubkey:  genDeposits[0].Pubkey,
+		},
+		{
+			SourceAddress: addressX,
+			SourcePubkey:  genDeposits[1].Pubkey,
+			TargetPubkey:  genDeposits[0].Pubkey,
+		},
+		{
+			SourceAddress: addressY,
+			SourcePubkey:  genDeposits[2].Pubkey,
+			TargetPubkey:  genDeposits[2].Pubkey,
+		},
+	}
+	blk := buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	// the stake of the source is moved to the target once
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, 2*maxBalance, balance)
+
+	balance, err = st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(0), balance)
+
+	// effective balances only follow at the end of the epoch
+	source, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, source.GetEffectiveBalance())
+	require.Equal(t, math.Epoch(1), source.GetExitEpoch())
+	require.Equal(t, math.Epoch(2), source.GetWithdrawableEpoch())
+
+	switched, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.True(t, switched.HasCompoundingWithdrawalCredential())
+
+	// STEP 2: move the chain to the next epoch and show that the target
+	// effective balance exceeds the non compounding max effective balance.
+	_, _ = moveToNextElectraEpoch(t, blk, cs, sp, st, ctx)
+
+	target, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, 2*maxBalance, target.GetEffectiveBalance())
+
+	source, err = st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(0), source.GetEffectiveBalance())
+	require.Equal(
+		t,
+		math.Epoch(constants.FarFutureEpoch),
+		target.GetExitEpoch(),
+	)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// processForkUpgrade upgrades the beacon state at the start of the epoch in
+// which the chain spec activates a new fork version. Forks scheduled for the
+// same epoch are applied in order.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processForkUpgrade(
+	st BeaconStateT,
+	epoch math.Epoch,
+) error {
+	// The genesis fork is written when the state is initialized.
+	if epoch == math.Epoch(constants.GenesisEpoch) {
+		return nil
+	}
+
+	var (
+		prevVersion = sp.cs.ActiveForkVersionForEpoch(epoch - 1)
+		nextVersion = sp.cs.ActiveForkVersionForEpoch(epoch)
+	)
+	for v := prevVersion + 1; v <= nextVersion; v++ {
+		if err := sp.upgradeState(st, v, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeState migrates the beacon state to the containers of the given fork
+// version and rotates the fork of the state.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) upgradeState(
+	st BeaconStateT,
+	forkVersion uint32,
+	epoch math.Epoch,
+) error {
+	var err error
+	switch forkVersion {
+	case version.DenebPlus:
+		// Deneb+ does not change the state containers.
+	case version.Electra:
+		err = sp.upgradeToElectra(st)
+	default:
+		return ErrUnsupportedForkUpgrade
+	}
+	if err != nil {
+		return err
+	}
+
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if err = st.SetFork(fork.New(
+		fork.GetCurrentVersion(),
+		version.FromUint32[common.Version](forkVersion),
+		epoch,
+	)); err != nil {
+		return err
+	}
+
+	sp.logger.Info(
+		"Upgraded beacon state to new fork 🍴",
+		"fork_version", forkVersion, "epoch", epoch.Base10(),
+	)
+	return nil
+}
+
+// upgradeToElectra migrates the beacon state to the Electra containers. No
+// EIP-6110 deposit request has been processed yet.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) upgradeToElectra(st BeaconStateT) error {
+	return st.SetDepositRequestsStartIndex(
+		constants.UnsetDepositRequestsStartIndex,
+	)
+}
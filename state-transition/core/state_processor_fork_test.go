@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestForkUpgrades shows that the state is upgraded at the activation epoch
+// of each fork in the chain spec schedule.
+func TestForkUpgrades(t *testing.T) {
+	const farEpoch = 9999999999999998
+
+	deneb := version.FromUint32[common.Version](version.Deneb)
+	denebPlus := version.FromUint32[common.Version](version.DenebPlus)
+	electra := version.FromUint32[common.Version](version.Electra)
+
+	testCases := []struct {
+		name                  string
+		denebPlusForkEpoch    math.Epoch
+		electraForkEpoch      math.Epoch
+		slot                  math.Slot
+		expectedFork          *types.Fork
+		expectedRequestsUnset bool
+	}{
+		{
+			name:               "no fork scheduled",
+			denebPlusForkEpoch: farEpoch,
+			electraForkEpoch:   farEpoch + 1,
+			slot:               64,
+			expectedFork: &types.Fork{
+				PreviousVersion: deneb, CurrentVersion: deneb,
+			},
+		},
+		{
+			name:               "before the fork epoch",
+			denebPlusForkEpoch: 2,
+			electraForkEpoch:   farEpoch,
+			slot:               63,
+			expectedFork: &types.Fork{
+				PreviousVersion: deneb, CurrentVersion: deneb,
+			},
+		},
+		{
+			name:               "deneb to deneb+",
+			denebPlusForkEpoch: 1,
+			electraForkEpoch:   farEpoch,
+			slot:               40,
+			expectedFork: &types.Fork{
+				PreviousVersion: deneb, CurrentVersion: denebPlus, Epoch: 1,
+			},
+		},
+		{
+			name:               "deneb+ to electra",
+			denebPlusForkEpoch: 1,
+			electraForkEpoch:   2,
+			slot:               64,
+			expectedFork: &types.Fork{
+				PreviousVersion: denebPlus, CurrentVersion: electra, Epoch: 2,
+			},
+			expectedRequestsUnset: true,
+		},
+		{
+			name:               "deneb+ and electra in the same epoch",
+			denebPlusForkEpoch: 1,
+			electraForkEpoch:   1,
+			slot:               32,
+			expectedFork: &types.Fork{
+				PreviousVersion: denebPlus, CurrentVersion: electra, Epoch: 1,
+			},
+			expectedRequestsUnset: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			specData := spec.BaseSpec()
+			specData.DenebPlusForkEpoch = tc.denebPlusForkEpoch
+			specData.ElectraForkEpoch = tc.electraForkEpoch
+			cs, err := chain.NewChainSpec(specData)
+			require.NoError(t, err)
+			sp, st, _, _ := setupState(t, cs)
+
+			_, err = sp.InitializePreminedBeaconStateFromEth1(
+				st,
+				[]*types.Deposit{{
+					Pubkey: [48]byte{0x01},
+					Credentials: types.NewCredentialsFromExecutionAddress(
+						common.ExecutionAddress{},
+					),
+					Amount: math.Gwei(cs.MaxEffectiveBalance(false)),
+				}},
+				new(types.ExecutionPayloadHeader).Empty(),
+				deneb,
+			)
+			require.NoError(t, err)
+			require.NoError(t, st.SetDepositRequestsStartIndex(0))
+
+			_, err = sp.ProcessSlots(st, tc.slot)
+			require.NoError(t, err)
+
+			fork, err := st.GetFork()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFork, fork)
+
+			startIndex, err := st.GetDepositRequestsStartIndex()
+			require.NoError(t, err)
+			require.Equal(t,
+				tc.expectedRequestsUnset,
+				startIndex == constants.UnsetDepositRequestsStartIndex,
+			)
+		})
+	}
+}
@@ -61,6 +61,15 @@ func (sp *StateProcessor[
 
 	// Eth1DepositIndex will be set in processDeposit
 
+	// No EIP-6110 deposit request has been processed yet.
+	if version.ToUint32(genesisVersion) >= version.Electra {
+		if err := st.SetDepositRequestsStartIndex(
+			constants.UnsetDepositRequestsStartIndex,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	var eth1Data *ctypes.Eth1Data
 	eth1Data = eth1Data.New(
 		common.Root{},
@@ -25,9 +25,12 @@ import (
 
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -189,12 +192,28 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// From Electra the execution requests are sent along with the payload.
+	var (
+		forkVersion       = sp.cs.ActiveForkVersionForSlot(blk.GetSlot())
+		executionRequests []bytes.Bytes
+	)
+	if forkVersion >= version.Electra {
+		executionRequests, err = ctypes.EncodeExecutionRequests(
+			body.GetExecutionRequests(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			executionRequests,
+			forkVersion,
 			optimisticEngine,
 		),
 	); err != nil {
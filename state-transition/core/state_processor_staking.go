@@ -22,8 +22,10 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
@@ -51,7 +53,20 @@ func (sp *StateProcessor[
 			sp.cs.MaxDepositsPerBlock(), len(deposits),
 		)
 	}
-	if err := sp.validateNonGenesisDeposits(st, deposits); err != nil {
+
+	isElectra := sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) >=
+		version.Electra
+	depositsLimit := constants.UnsetDepositRequestsStartIndex
+	if isElectra {
+		var err error
+		depositsLimit, err = sp.legacyDepositsLimit(st, blk)
+		if err != nil {
+			return err
+		}
+	}
+	if err := sp.validateNonGenesisDeposits(
+		st, deposits, depositsLimit,
+	); err != nil {
 		return err
 	}
 	for _, dep := range deposits {
@@ -59,9 +74,171 @@ func (sp *StateProcessor[
 			return err
 		}
 	}
+
+	if !isElectra {
+		return nil
+	}
+	requests := blk.GetBody().GetExecutionRequests()
+	if requests != nil {
+		if err := sp.processDepositRequests(st, requests.Deposits); err != nil {
+			return err
+		}
+	}
+	if err := sp.processPendingDeposits(st); err != nil {
+		return err
+	}
+	if requests != nil {
+		for _, req := range requests.Consolidations {
+			if err := sp.processConsolidationRequest(st, req); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// legacyDepositsLimit returns the first deposit index which must not be
+// included in the block deposits, as it is delivered through the EIP-6110
+// deposit requests instead. Until the first deposit request is processed,
+// the limit is the index of the first deposit request of the block, if any.
+func (sp *StateProcessor[
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) legacyDepositsLimit(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (uint64, error) {
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return 0, err
+	}
+	requests := blk.GetBody().GetDepositRequests()
+	if startIndex == constants.UnsetDepositRequestsStartIndex &&
+		len(requests) > 0 {
+		startIndex = requests[0].GetIndex().Unwrap()
+	}
+	return startIndex, nil
+}
+
+// processDepositRequests processes the EIP-6110 deposit requests of a block.
+// Deposit requests are validated by the execution client as part of the
+// payload, so they are not checked against the deposit store. Like the
+// pending deposits of the Electra specs, they are queued in the state until
+// the legacy deposits preceding them are processed.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processDepositRequests(
+	st BeaconStateT,
+	deps []*ctypes.Deposit,
+) error {
+	if len(deps) == 0 {
+		return nil
+	}
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		startIndex = deps[0].GetIndex().Unwrap()
+		if err = st.SetDepositRequestsStartIndex(startIndex); err != nil {
+			return err
+		}
+		sp.logger.Info(
+			"Processed first deposit request",
+			"deposit_requests_start_index", startIndex,
+		)
+	}
+	pending, err := st.GetPendingDeposits()
+	if err != nil {
+		return err
+	}
+	if uint64(len(pending)+len(deps)) > constants.PendingDepositsLimit {
+		return errors.Wrapf(
+			ErrExceedsPendingDepositsLimit, "pending: %d, requests: %d",
+			len(pending), len(deps),
+		)
+	}
+	return st.SetPendingDeposits(append(pending, deps...))
+}
+
+// processPendingDeposits applies the queued deposit requests in order, once
+// the legacy deposits up to the deposit requests start index are processed.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _,
+	WithdrawalCredentialsT,
+]) processPendingDeposits(st BeaconStateT) error {
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		return nil
+	}
+	nextIndex, err := sp.nextLegacyDepositIndex(st)
+	if err != nil {
+		return err
+	}
+	if nextIndex < startIndex {
+		// Some legacy deposits are still to be processed.
+		return nil
+	}
+
+	pending, err := st.GetPendingDeposits()
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	for _, pd := range pending {
+		var dep DepositT
+		dep = dep.New(
+			pd.GetPubkey(),
+			WithdrawalCredentialsT(pd.GetWithdrawalCredentials()),
+			pd.GetAmount(),
+			pd.GetSignature(),
+			pd.GetIndex().Unwrap(),
+		)
+		if err = sp.applyDeposit(st, dep); err != nil {
+			return err
+		}
+	}
+	return st.SetPendingDeposits(nil)
+}
+
+// nextLegacyDepositIndex returns the index of the next deposit to be
+// included in the block deposits.
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) nextLegacyDepositIndex(st BeaconStateT) (uint64, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return 0, err
+	}
+	return NextLegacyDepositIndex(sp.cs, slot, depositIndex), nil
+}
+
+// NextLegacyDepositIndex returns the index of the next deposit to be
+// included in the block deposits, given the slot and the eth1 deposit index
+// of the state.
+func NextLegacyDepositIndex(
+	cs common.ChainSpec, slot math.Slot, depositIndex uint64,
+) uint64 {
+	switch {
+	case cs.DepositEth1ChainID() == spec.BartioChainID,
+		cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
+			slot != 0 && slot < math.U64(spec.BoonetFork2Height):
+		// The deposit index already points to the next deposit, see
+		// processDeposit.
+		return depositIndex
+	default:
+		return depositIndex + 1
+	}
+}
+
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
 	_, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _,
@@ -158,8 +335,11 @@ func (sp *StateProcessor[
 	// Get the current epoch.
 	epoch := sp.cs.SlotToEpoch(slot)
 
-	// Verify that the deposit has the ETH1 withdrawal credentials.
-	if !dep.HasEth1WithdrawalCredentials() {
+	// Verify that the deposit has the ETH1 withdrawal credentials, or the
+	// compounding ones from Electra.
+	isElectra := sp.cs.ActiveForkVersionForEpoch(epoch) >= version.Electra
+	if !dep.HasEth1WithdrawalCredentials() &&
+		!(isElectra && dep.HasCompoundingWithdrawalCredentials()) {
 		// Ignore deposits with non-ETH1 withdrawal credentials.
 		sp.logger.Info(
 			"ignoring deposit with non-ETH1 withdrawal credentials",
@@ -206,9 +386,9 @@ func (sp *StateProcessor[
 		dep.GetWithdrawalCredentials(),
 		dep.GetAmount(),
 		math.Gwei(sp.cs.EffectiveBalanceIncrement()),
-		math.Gwei(sp.cs.MaxEffectiveBalance(
-			state.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
-		)),
+		state.MaxEffectiveBalance(
+			sp.cs, dep.HasCompoundingWithdrawalCredentials(), slot,
+		),
 	)
 
 	// TODO: This is a bug that lives on bArtio. Delete this eventually.
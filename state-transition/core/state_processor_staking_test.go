@@ -35,6 +35,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -104,7 +105,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -155,7 +156,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -243,7 +244,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -295,7 +296,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -339,7 +340,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -441,7 +442,7 @@ func TestTransitionWithdrawals(t *testing.T) {
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
 					// The first withdrawal is always for EVM inflation.
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 					// Partially withdraw validator 1 by minBalance.
 					{
 						Index:     0,
@@ -537,7 +538,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
 					// The first withdrawal is always for EVM inflation.
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 					// Partially withdraw validator 0 by minBalance.
 					{
 						Index:     0,
@@ -583,7 +584,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
 					// The first withdrawal is always for EVM inflation.
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 					// Partially withdraw validator 1 by minBalance.
 					{
 						Index:     1,
@@ -684,7 +685,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -736,7 +737,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -781,7 +782,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -818,7 +819,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 					{
 						Index:     0,
 						Validator: extraValIdx,
@@ -916,7 +917,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -985,7 +986,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -1045,7 +1046,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
@@ -1111,7 +1112,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				ExtraData:    []byte("testing"),
 				Transactions: [][]byte{},
 				Withdrawals: []*engineprimitives.Withdrawal{
-					st.EVMInflationWithdrawal(),
+					nextEVMInflationWithdrawal(t, st),
 					{
 						Index:     0,
 						Validator: smallestValIdx,
@@ -1178,7 +1179,7 @@ func moveToEndOfEpoch(
 					ExtraData:    []byte("testing"),
 					Transactions: [][]byte{},
 					Withdrawals: []*engineprimitives.Withdrawal{
-						st.EVMInflationWithdrawal(),
+						nextEVMInflationWithdrawal(t, st),
 					},
 					BaseFeePerGas: math.NewU256(0),
 				},
@@ -1193,3 +1194,299 @@ func moveToEndOfEpoch(
 	}
 	return blk
 }
+
+// buildElectraBlockBody returns an Electra block body, with no deposits nor
+// execution requests, carrying a payload with the given timestamp.
+func buildElectraBlockBody(
+	t *testing.T,
+	st *TestBeaconStateT,
+	timestamp uint64,
+) *types.BeaconBlockBody {
+	t.Helper()
+
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload = &types.ExecutionPayload{
+		Timestamp:    math.U64(timestamp),
+		ExtraData:    []byte("testing"),
+		Transactions: [][]byte{},
+		Withdrawals: []*engineprimitives.Withdrawal{
+			nextEVMInflationWithdrawal(t, st),
+		},
+		BaseFeePerGas: math.NewU256(0),
+	}
+	body.Eth1Data = &types.Eth1Data{}
+	body.Deposits = []*types.Deposit{}
+	return body
+}
+
+// moveToNextElectraEpoch builds and processes Electra blocks until the chain
+// moves to the next epoch, returning the last block and the validator updates
+// of the epoch transition.
+func moveToNextElectraEpoch(
+	t *testing.T,
+	tip *types.BeaconBlock,
+	cs chain.Spec[bytes.B4, math.U64, common.ExecutionAddress, math.U64, any],
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+) (*types.BeaconBlock, transition.ValidatorUpdates) {
+	t.Helper()
+	var (
+		blk       = tip
+		currEpoch = cs.SlotToEpoch(blk.GetSlot())
+		vals      transition.ValidatorUpdates
+		err       error
+	)
+	for currEpoch == cs.SlotToEpoch(blk.GetSlot()) {
+		blk = buildNextBlock(t, st, buildElectraBlockBody(
+			t, st, blk.Body.ExecutionPayload.Timestamp.Unwrap()+1,
+		))
+		vals, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+	}
+	return blk, vals
+}
+
+// TestTransitionDepositRequests shows that after Electra deposits are taken
+// from the execution requests of the payload, while legacy deposits preceding
+// the first deposit request are still drained from the deposit store.
+func TestTransitionDepositRequests(t *testing.T) {
+	specData := spec.BaseSpec()
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance       = math.Gwei(cs.MaxEffectiveBalance(false))
+		minBalance       = math.Gwei(cs.EjectionBalance())
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: emptyCredentials,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+	}
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, constants.UnsetDepositRequestsStartIndex, startIndex)
+
+	// STEP 1: the first deposit request is processed along with the last
+	// legacy deposit. The deposit store also holds the deposit request,
+	// read from the deposit contract logs.
+	var (
+		legacyDeposit = &types.Deposit{
+			Pubkey:      [48]byte{0x01},
+			Credentials: emptyCredentials,
+			Amount:      maxBalance,
+			Index:       1,
+		}
+		depositRequest = &types.Deposit{
+			Pubkey:      [48]byte{0x02},
+			Credentials: emptyCredentials,
+			Amount:      minBalance,
+			Index:       2,
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(
+		[]*types.Deposit{legacyDeposit, depositRequest},
+	))
+
+	body := buildElectraBlockBody(t, st, 10)
+	body.Deposits = []*types.Deposit{legacyDeposit}
+	body.ExecutionRequests.Deposits = []*types.Deposit{depositRequest}
+	blk := buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	startIndex, err = st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Index, startIndex)
+
+	// deposit requests do not move the legacy deposit index
+	depositIndex, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, legacyDeposit.Index, depositIndex)
+
+	idx, err := st.ValidatorIndexByPubkey(depositRequest.Pubkey)
+	require.NoError(t, err)
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Amount, balance)
+
+	// STEP 2: further deposit requests top up validators directly.
+	topUp := &types.Deposit{
+		Pubkey:      depositRequest.Pubkey,
+		Credentials: emptyCredentials,
+		Amount:      minBalance,
+		Index:       3,
+	}
+	body = buildElectraBlockBody(t, st, 11)
+	body.ExecutionRequests.Deposits = []*types.Deposit{topUp}
+	blk = buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	balance, err = st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Amount+topUp.Amount, balance)
+
+	startIndex, err = st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Index, startIndex)
+
+	// STEP 3: once the legacy queue is drained, deposits from the store are
+	// rejected as they have been delivered through the deposit requests.
+	body = buildElectraBlockBody(t, st, 12)
+	body.Deposits = []*types.Deposit{depositRequest}
+	blk = buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.ErrorIs(t, err, core.ErrDepositsLengthMismatch)
+}
+
+// TestTransitionDepositRequestsWaitForLegacyDeposits shows that deposit
+// requests are queued in the state until the legacy deposits preceding them
+// are processed, and are then applied in order.
+func TestTransitionDepositRequestsWaitForLegacyDeposits(t *testing.T) {
+	specData := spec.BaseSpec()
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance       = math.Gwei(cs.MaxEffectiveBalance(false))
+		minBalance       = math.Gwei(cs.EjectionBalance())
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: emptyCredentials,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+	}
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+
+	// STEP 1: the deposit requests are included before the legacy deposit
+	// preceding them has reached the deposit store.
+	var (
+		legacyDeposit = &types.Deposit{
+			Pubkey:      [48]byte{0x01},
+			Credentials: emptyCredentials,
+			Amount:      maxBalance,
+			Index:       1,
+		}
+		depositRequest = &types.Deposit{
+			Pubkey:      [48]byte{0x02},
+			Credentials: emptyCredentials,
+			Amount:      minBalance,
+			Index:       2,
+		}
+		topUp = &types.Deposit{
+			Pubkey:      depositRequest.Pubkey,
+			Credentials: emptyCredentials,
+			Amount:      minBalance,
+			Index:       3,
+		}
+	)
+	body := buildElectraBlockBody(t, st, 10)
+	body.ExecutionRequests.Deposits = []*types.Deposit{depositRequest, topUp}
+	blk := buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Index, startIndex)
+
+	pending, err := st.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Deposit{depositRequest, topUp}, pending)
+
+	_, err = st.ValidatorIndexByPubkey(depositRequest.Pubkey)
+	require.Error(t, err)
+
+	// STEP 2: once the legacy deposit is processed, the pending deposits are
+	// applied in order.
+	require.NoError(t, ds.EnqueueDeposits([]*types.Deposit{legacyDeposit}))
+
+	body = buildElectraBlockBody(t, st, 11)
+	body.Deposits = []*types.Deposit{legacyDeposit}
+	blk = buildNextBlock(t, st, body)
+
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	pending, err = st.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	legacyIdx, err := st.ValidatorIndexByPubkey(legacyDeposit.Pubkey)
+	require.NoError(t, err)
+	idx, err := st.ValidatorIndexByPubkey(depositRequest.Pubkey)
+	require.NoError(t, err)
+	require.Greater(t, idx, legacyIdx)
+
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, depositRequest.Amount+topUp.Amount, balance)
+}
+
+// TestNextLegacyDepositIndex shows that the eth1 deposit index of Bartio, and
+// of Boonet before its second fork, already points to the next deposit.
+func TestNextLegacyDepositIndex(t *testing.T) {
+	for _, tc := range []struct {
+		chainID uint64
+		slot    math.Slot
+		want    uint64
+	}{
+		{chainID: spec.DevnetEth1ChainID, slot: 10, want: 6},
+		{chainID: spec.BartioChainID, slot: 10, want: 5},
+		{chainID: spec.BoonetEth1ChainID, slot: 10, want: 5},
+		{chainID: spec.BoonetEth1ChainID, slot: 0, want: 6},
+		{
+			chainID: spec.BoonetEth1ChainID,
+			slot:    math.Slot(spec.BoonetFork2Height),
+			want:    6,
+		},
+	} {
+		specData := spec.BaseSpec()
+		specData.DepositEth1ChainID = tc.chainID
+		cs, err := chain.NewChainSpec(specData)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, core.NextLegacyDepositIndex(cs, tc.slot, 5))
+	}
+}
@@ -22,13 +22,16 @@ package core
 
 import (
 	stdbytes "bytes"
+	"cmp"
 	"fmt"
 	"slices"
 
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/sourcegraph/conc/iter"
 )
 
@@ -66,8 +69,12 @@ func (sp *StateProcessor[
 		sp.cs.EjectionBalance() + sp.cs.EffectiveBalanceIncrement(),
 	)
 
-	// We do not currently have a cap on validator churn,
-	// so we can process validators activations in a single loop
+	// Before Electra we do not have a cap on validator churn, so we can
+	// process validators activations in a single loop. From Electra,
+	// validators eligible for activation are queued and activated below.
+	isChurnLimited := sp.cs.ActiveForkVersionForEpoch(currEpoch) >=
+		version.Electra
+	activationQueue := make([]ValidatorT, 0)
 	var idx math.ValidatorIndex
 	for si, val := range vals {
 		valModified := false
@@ -76,8 +83,12 @@ func (sp *StateProcessor[
 			valModified = true
 		}
 		if val.IsEligibleForActivation(currEpoch) {
-			val.SetActivationEpoch(nextEpoch)
-			valModified = true
+			if isChurnLimited {
+				activationQueue = append(activationQueue, val)
+			} else {
+				val.SetActivationEpoch(nextEpoch)
+				valModified = true
+			}
 		}
 		// Note: without slashing and voluntary withdrawals, there is no way
 		// for an activa validator to have its balance less or equal to
@@ -102,6 +113,14 @@ func (sp *StateProcessor[
 		}
 	}
 
+	if isChurnLimited {
+		if err = sp.processActivationQueue(
+			st, activationQueue, nextEpoch,
+		); err != nil {
+			return err
+		}
+	}
+
 	// validators registry will be possibly further modified in order to enforce
 	// validators set cap. We will do that at the end of processEpoch, once all
 	// Eth 2.0 like transitions has been done (notable EffectiveBalances
@@ -109,6 +128,53 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// processActivationQueue activates the validators of the activation queue,
+// ordered by eligibility epoch, up to the activation churn limit.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#modified-process_registry_updates
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processActivationQueue(
+	st BeaconStateT,
+	activationQueue []ValidatorT,
+	activationEpoch math.Epoch,
+) error {
+	// validators are listed by index, so a stable sort breaks ties by index
+	slices.SortStableFunc(activationQueue, func(lhs, rhs ValidatorT) int {
+		return cmp.Compare(
+			lhs.GetActivationEligibilityEpoch(),
+			rhs.GetActivationEligibilityEpoch(),
+		)
+	})
+
+	churnLimit, err := sp.activationChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if uint64(len(activationQueue)) > churnLimit {
+		activationQueue = activationQueue[:churnLimit]
+	}
+
+	var idx math.ValidatorIndex
+	for _, val := range activationQueue {
+		val.SetActivationEpoch(activationEpoch)
+		idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return fmt.Errorf(
+				"activation queue, failed loading validator index: %w",
+				err,
+			)
+		}
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return fmt.Errorf(
+				"activation queue, failed activating validator idx %d: %w",
+				idx,
+				err,
+			)
+		}
+	}
+	return nil
+}
+
 func (sp *StateProcessor[
 	_, _, BeaconStateT, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processValidatorSetCap(
@@ -116,14 +182,15 @@ func (sp *StateProcessor[
 ) error {
 	// Enforce the validator set cap by:
 	// 1- retrieving validators active next epoch
-	// 2- sorting them by stake
+	// 2- sorting them by stake, favoring consolidated stake on ties
 	// 3- dropping enough validators to fulfill the cap
 
 	slot, err := st.GetSlot()
 	if err != nil {
 		return err
 	}
-	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+	currEpoch := sp.cs.SlotToEpoch(slot)
+	nextEpoch := currEpoch + 1
 
 	nextEpochVals, err := sp.getActiveVals(st, nextEpoch)
 	if err != nil {
@@ -133,6 +200,20 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// From Electra, validators already in the exit queue may still be active
+	// next epoch. They will leave the set anyway, so we do not count them.
+	isChurnLimited := sp.cs.ActiveForkVersionForEpoch(currEpoch) >=
+		version.Electra
+	if isChurnLimited {
+		nextEpochVals = slices.DeleteFunc(
+			nextEpochVals,
+			func(v ValidatorT) bool {
+				return v.GetExitEpoch() !=
+					math.Epoch(constants.FarFutureEpoch)
+			},
+		)
+	}
+
 	if uint64(len(nextEpochVals)) <= sp.cs.ValidatorSetCap() {
 		// nothing to eject
 		return nil
@@ -148,6 +229,13 @@ func (sp *StateProcessor[
 			return -1
 		case val1Stake > val2Stake:
 			return 1
+		case lhs.HasCompoundingWithdrawalCredential() !=
+			rhs.HasCompoundingWithdrawalCredential():
+			// compounding validators are dropped last
+			if lhs.HasCompoundingWithdrawalCredential() {
+				return 1
+			}
+			return -1
 		default:
 			// validators pks are guaranteed to be different
 			var (
@@ -158,13 +246,12 @@ func (sp *StateProcessor[
 		}
 	})
 
-	// We do not currently have a cap on validators churn, so we stop
-	// validators next epoch and we withdraw them the epoch after
+	// Before Electra we do not have a cap on validators churn, so we stop
+	// validators next epoch and we withdraw them the epoch after. From
+	// Electra, validators join the exit queue.
 	var idx math.ValidatorIndex
 	for li := range uint64(len(nextEpochVals)) - sp.cs.ValidatorSetCap() {
 		valToEject := nextEpochVals[li]
-		valToEject.SetExitEpoch(nextEpoch)
-		valToEject.SetWithdrawableEpoch(nextEpoch + 1)
 		idx, err = st.ValidatorIndexByPubkey(valToEject.GetPubkey())
 		if err != nil {
 			return fmt.Errorf(
@@ -172,7 +259,14 @@ func (sp *StateProcessor[
 				err,
 			)
 		}
-		if err = st.UpdateValidatorAtIndex(idx, valToEject); err != nil {
+		if isChurnLimited {
+			err = sp.initiateValidatorExit(st, idx, valToEject)
+		} else {
+			valToEject.SetExitEpoch(nextEpoch)
+			valToEject.SetWithdrawableEpoch(nextEpoch + 1)
+			err = st.UpdateValidatorAtIndex(idx, valToEject)
+		}
+		if err != nil {
 			return fmt.Errorf(
 				"validator cap, failed ejecting validator idx %d: %w",
 				li,
@@ -184,6 +278,89 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// initiateValidatorExit as defined in the Ethereum 2.0 specification, with
+// validators exiting at the next epoch at the earliest and becoming
+// withdrawable the epoch after they exit.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+) error {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if val.GetExitEpoch() != farFutureEpoch {
+		// validator already exiting
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// the exit queue epoch is the latest exit epoch already scheduled
+	exitQueueEpoch := sp.cs.SlotToEpoch(slot) + 1
+	for _, v := range vals {
+		if exitEpoch := v.GetExitEpoch(); exitEpoch != farFutureEpoch {
+			exitQueueEpoch = max(exitQueueEpoch, exitEpoch)
+		}
+	}
+	var exitQueueChurn uint64
+	for _, v := range vals {
+		if v.GetExitEpoch() == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	churnLimit, err := sp.validatorChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if exitQueueChurn >= churnLimit {
+		exitQueueEpoch++
+	}
+
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(exitQueueEpoch + 1)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// validatorChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) validatorChurnLimit(st BeaconStateT) (uint64, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	activeVals, err := sp.getActiveVals(st, sp.cs.SlotToEpoch(slot))
+	if err != nil {
+		return 0, err
+	}
+	return max(
+		sp.cs.MinPerEpochChurnLimit(),
+		uint64(len(activeVals))/sp.cs.ChurnLimitQuotient(),
+	), nil
+}
+
+// activationChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#new-get_validator_activation_churn_limit
+func (sp *StateProcessor[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activationChurnLimit(st BeaconStateT) (uint64, error) {
+	churnLimit, err := sp.validatorChurnLimit(st)
+	if err != nil {
+		return 0, err
+	}
+	return min(sp.cs.MaxPerEpochActivationChurnLimit(), churnLimit), nil
+}
+
 // Note: validatorSetsDiffs does not need to be a StateProcessor method
 // but it helps simplifying generic instantiation.
 // TODO: Turn this into a free function
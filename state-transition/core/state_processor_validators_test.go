@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionActivationChurn shows that from Electra validators eligible
+// for activation are activated in index order, up to the activation churn
+// limit per epoch.
+func TestTransitionActivationChurn(t *testing.T) {
+	const activationChurnLimit = 2
+
+	specData := spec.BaseSpec()
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	specData.MinPerEpochChurnLimit = activationChurnLimit
+	specData.MaxPerEpochActivationChurnLimit = activationChurnLimit
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	sp, st, _, ctx := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance(false))
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+
+	// STEP 1: a deposit wave creates five new validators
+	body := buildElectraBlockBody(t, st, 10)
+	for i := range uint64(5) {
+		body.ExecutionRequests.Deposits = append(
+			body.ExecutionRequests.Deposits,
+			&types.Deposit{
+				Pubkey:      [48]byte{byte(i + 1)},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       i + 1,
+			},
+		)
+	}
+	blk := buildNextBlock(t, st, body)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	// STEP 2: validators become eligible for activation at the first epoch
+	// turn, then at most activationChurnLimit of them join the set per epoch.
+	blk, valDiff := moveToNextElectraEpoch(t, blk, cs, sp, st, ctx)
+	require.Empty(t, valDiff)
+
+	for _, expected := range [][]byte{{0x01, 0x02}, {0x03, 0x04}, {0x05}} {
+		blk, valDiff = moveToNextElectraEpoch(t, blk, cs, sp, st, ctx)
+		require.Len(t, valDiff, len(expected))
+		for i, update := range valDiff {
+			require.Equal(t, expected[i], update.Pubkey[0])
+			require.Equal(t, maxBalance, update.EffectiveBalance)
+		}
+	}
+}
+
+// TestTransitionExitQueue shows that from Electra exiting validators join
+// an exit queue, which moves forward by the churn limit per epoch.
+func TestTransitionExitQueue(t *testing.T) {
+	specData := spec.BaseSpec()
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	specData.MinPerEpochChurnLimit = 1
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	sp, st, _, ctx := setupState(t, cs)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		address    = common.ExecutionAddress{0x0a}
+	)
+
+	// STEP 0: Setup initial state via genesis, with a compounding validator
+	// and three validators to be consolidated into it.
+	genDeposits := []*types.Deposit{{
+		Pubkey: [48]byte{0x00},
+		Credentials: types.NewCompoundingCredentialsFromExecutionAddress(
+			address,
+		),
+		Amount: maxBalance,
+		Index:  0,
+	}}
+	for i := range uint64(3) {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i + 1)},
+			Credentials: types.NewCredentialsFromExecutionAddress(address),
+			Amount:      maxBalance,
+			Index:       i + 1,
+		})
+	}
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+
+	// STEP 1: consolidating all of them, one validator exits per epoch
+	body := buildElectraBlockBody(t, st, 10)
+	for _, dep := range genDeposits[1:] {
+		body.ExecutionRequests.Consolidations = append(
+			body.ExecutionRequests.Consolidations,
+			&types.ConsolidationRequest{
+				SourceAddress: address,
+				SourcePubkey:  dep.Pubkey,
+				TargetPubkey:  genDeposits[0].Pubkey,
+			},
+		)
+	}
+	blk := buildNextBlock(t, st, body)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	for i := range uint64(3) {
+		val, errVal := st.ValidatorByIndex(math.ValidatorIndex(i + 1))
+		require.NoError(t, errVal)
+		require.Equal(t, math.Epoch(i+1), val.GetExitEpoch())
+		require.Equal(t, math.Epoch(i+2), val.GetWithdrawableEpoch())
+	}
+}
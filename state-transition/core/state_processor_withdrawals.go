@@ -210,7 +210,7 @@ func (sp *StateProcessor[
 	if len(payloadWithdrawals) == 0 {
 		return ErrZeroWithdrawals
 	}
-	if !payloadWithdrawals[0].Equals(st.EVMInflationWithdrawal()) {
+	if !payloadWithdrawals[0].Equals(st.EVMInflationWithdrawal(slot)) {
 		return ErrFirstWithdrawalNotEVMInflation
 	}
 	numWithdrawals := len(expectedWithdrawals)
@@ -24,6 +24,7 @@ import (
 	stdbytes "bytes"
 	"context"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -82,6 +83,12 @@ type BeaconBlockBody[
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetExecutionRequests returns the execution requests, nil before
+	// Electra.
+	GetExecutionRequests() *ctypes.ExecutionRequests
+	// GetDepositRequests returns the EIP-6110 deposit requests, nil before
+	// Electra.
+	GetDepositRequests() []DepositT
 }
 
 // Context defines an interface for managing state transition context.
@@ -115,6 +122,14 @@ type Deposit[
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// New creates a new deposit.
+	New(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawlCredentialsT,
+		amount math.Gwei,
+		signature crypto.BLSSignature,
+		index uint64,
+	) DepositT
 	// Equals returns true if the Deposit is equal to the other.
 	Equals(DepositT) bool
 	// GetAmount returns the amount of the deposit.
@@ -128,6 +143,9 @@ type Deposit[
 	// HasEth1WithdrawalCredentials returns true if the deposit has eth1
 	// withdrawal credentials.
 	HasEth1WithdrawalCredentials() bool
+	// HasCompoundingWithdrawalCredentials returns true if the deposit has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredentials() bool
 	// VerifySignature verifies the deposit and creates a validator.
 	VerifySignature(
 		forkData ForkDataT,
@@ -234,6 +252,18 @@ type Validator[
 
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// HasExecutionWithdrawalCredential returns true if the validator has
+	// eth1 or compounding withdrawal credentials.
+	HasExecutionWithdrawalCredential() bool
+	// HasCompoundingWithdrawalCredential returns true if the validator has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredential() bool
+	// SwitchToCompoundingWithdrawalCredential switches the withdrawal
+	// credentials of the validator to compounding ones.
+	SwitchToCompoundingWithdrawalCredential()
 	// GetEffectiveBalance returns the effective balance of the validator in
 	// Gwei.
 	GetEffectiveBalance() math.Gwei
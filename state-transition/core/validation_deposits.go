@@ -104,6 +104,7 @@ func (sp *StateProcessor[
 ]) validateNonGenesisDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
+	depositsLimit uint64,
 ) error {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -142,6 +143,15 @@ func (sp *StateProcessor[
 			return err
 		}
 
+		// From Electra, deposits from depositsLimit onwards are delivered
+		// through deposit requests and must not be listed in the block.
+		for i, sd := range localDeposits {
+			if sd.GetIndex().Unwrap() >= depositsLimit {
+				localDeposits = localDeposits[:i]
+				break
+			}
+		}
+
 		sp.logger.Info(
 			"Processing deposits in range",
 			"expected_start_index", expectedStartIdx,
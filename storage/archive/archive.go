@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/storage/filedb"
+)
+
+// Archive is a cold store of values grouped by index.
+type Archive interface {
+	// Set stores value under key for index.
+	Set(index uint64, key []byte, value []byte) error
+	// GetByIndex returns every value stored for index.
+	GetByIndex(index uint64) ([][]byte, error)
+}
+
+// New creates the archive selected by cfg, or nil if it is disabled.
+// Relative local directories are resolved against homeDir.
+func New(cfg Config, homeDir string, logger log.Logger) (Archive, error) {
+	switch cfg.Backend {
+	case BackendNone:
+		return nil, nil
+	case BackendLocal:
+		dir := cfg.Directory
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(homeDir, dir)
+		}
+		return filedb.NewRangeDB(
+			filedb.NewDB(
+				filedb.WithRootDirectory(dir),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(logger),
+			),
+		), nil
+	case BackendS3:
+		return NewS3(cfg)
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "%q", cfg.Backend)
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+const (
+	// BackendNone disables the archive.
+	BackendNone = ""
+	// BackendLocal archives to a local directory tree.
+	BackendLocal = "local"
+	// BackendS3 archives to an S3-compatible bucket.
+	BackendS3 = "s3"
+
+	defaultDirectory = "data/blobs-archive"
+	defaultS3Region  = "us-east-1"
+)
+
+// Config is the configuration of the blob archive.
+type Config struct {
+	// Backend is the archive backend, either "", "local" or "s3".
+	Backend string `mapstructure:"backend"`
+	// Directory is the root of the local archive. Relative paths are
+	// resolved against the home directory.
+	Directory string `mapstructure:"directory"`
+	// S3Endpoint is the URL of the S3-compatible service.
+	S3Endpoint string `mapstructure:"s3-endpoint"`
+	// S3Bucket is the bucket sidecars are archived to.
+	S3Bucket string `mapstructure:"s3-bucket"`
+	// S3Prefix is prepended to the key of every archived sidecar.
+	S3Prefix string `mapstructure:"s3-prefix"`
+	// S3Region is the region requests are signed for.
+	S3Region string `mapstructure:"s3-region"`
+	// S3AccessKey is the access key ID. The AWS_ACCESS_KEY_ID environment
+	// variable is used when empty.
+	S3AccessKey string `mapstructure:"s3-access-key"`
+	// S3SecretKey is the secret access key. The AWS_SECRET_ACCESS_KEY
+	// environment variable is used when empty.
+	S3SecretKey string `mapstructure:"s3-secret-key"`
+}
+
+// DefaultConfig returns the default configuration of the blob archive,
+// which is disabled.
+func DefaultConfig() Config {
+	return Config{
+		Backend:   BackendNone,
+		Directory: defaultDirectory,
+		S3Region:  defaultS3Region,
+	}
+}
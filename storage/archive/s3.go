@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	phex "github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+const (
+	// objectExtension is the extension of archived objects.
+	objectExtension = ".ssz"
+	// requestTimeout is the deadline of a single S3 request.
+	requestTimeout = 30 * time.Second
+	// maxErrorBodySize bounds how much of an error response is read.
+	maxErrorBodySize = 1 << 10
+)
+
+// S3 archives values to an S3-compatible bucket, with path-style requests
+// signed with AWS Signature Version 4, so that MinIO and friends work as
+// well as AWS. Values are stored under "<prefix><index>/<0xkey>.ssz".
+type S3 struct {
+	// endpoint is the URL of the service.
+	endpoint *url.URL
+	// bucket is the bucket values are stored in.
+	bucket string
+	// prefix is prepended to every object key.
+	prefix string
+	// region is the region requests are signed for.
+	region string
+	// accessKey is the access key ID.
+	accessKey string
+	// secretKey is the secret access key.
+	secretKey string
+	// client is the HTTP client.
+	client *http.Client
+	// now returns the signing time.
+	now func() time.Time
+}
+
+// NewS3 creates a new S3 archive from cfg.
+func NewS3(cfg Config) (*S3, error) {
+	if cfg.S3Bucket == "" {
+		return nil, ErrMissingS3Bucket
+	}
+	endpoint, err := url.Parse(cfg.S3Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	accessKey, secretKey := cfg.S3AccessKey, cfg.S3SecretKey
+	if accessKey == "" {
+		accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+	}
+	if secretKey == "" {
+		secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	return &S3{
+		endpoint:  endpoint,
+		bucket:    cfg.S3Bucket,
+		prefix:    cfg.S3Prefix,
+		region:    cfg.S3Region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: requestTimeout},
+		now:       time.Now,
+	}, nil
+}
+
+// Set stores value under key for index.
+func (s *S3) Set(index uint64, key []byte, value []byte) error {
+	resp, err := s.do(
+		http.MethodPut, s.indexPrefix(index)+phex.EncodeBytes(key)+
+			objectExtension, nil, value,
+	)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetByIndex returns every value stored for index.
+func (s *S3) GetByIndex(index uint64) ([][]byte, error) {
+	keys, err := s.list(s.indexPrefix(index))
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		resp, gErr := s.do(http.MethodGet, key, nil, nil)
+		if gErr != nil {
+			return nil, gErr
+		}
+		value, gErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if gErr != nil {
+			return nil, gErr
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// listBucketResult is the response of ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// list returns the keys of every object starting with prefix.
+func (s *S3) list(prefix string) ([]string, error) {
+	var (
+		keys  []string
+		token string
+	)
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.do(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range result.Contents {
+			keys = append(keys, c.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// do sends a signed request for the object key, or for the bucket if key is
+// empty, and returns the response if it succeeded.
+func (s *S3) do(
+	method, key string,
+	query url.Values,
+	body []byte,
+) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	u.RawQuery = query.Encode()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	req, err := http.NewRequestWithContext(
+		ctx, method, u.String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		_ = resp.Body.Close()
+		return nil, errors.Wrapf(
+			ErrS3Request, "%s %s: %s: %s",
+			method, u.Path, resp.Status, strings.TrimSpace(string(msg)),
+		)
+	}
+	return resp, nil
+}
+
+// sign signs req with AWS Signature Version 4.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *S3) sign(req *http.Request, body []byte) {
+	payloadHash := sha256.Sum256(body)
+	amzDate := s.now().UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + hex.EncodeToString(payloadHash[:]) +
+			"\n" + "x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" +
+		hex.EncodeToString(canonicalHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization",
+		"AWS4-HMAC-SHA256 Credential="+s.accessKey+"/"+scope+
+			", SignedHeaders="+signedHeaders+", Signature="+signature,
+	)
+}
+
+// indexPrefix returns the key prefix of the objects of index.
+func (s *S3) indexPrefix(index uint64) string {
+	return s.prefix + strconv.FormatUint(index, 10) + "/"
+}
+
+// cancelBody releases the request context once the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the request context.
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
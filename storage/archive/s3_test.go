@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/archive"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is an in-memory stand-in for an S3-compatible service such as MinIO.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) ||
+		!strings.HasPrefix(
+			r.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential=access/",
+		) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+	case r.Method == http.MethodGet && key == "":
+		f.list(w, r.URL.Query().Get("prefix"))
+	case r.Method == http.MethodGet:
+		value, found := f.objects[key]
+		if !found {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(value)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key string `xml:"Key"`
+	}
+	var result struct {
+		XMLName  xml.Name  `xml:"ListBucketResult"`
+		Contents []content `xml:"Contents"`
+	}
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) {
+			result.Contents = append(result.Contents, content{Key: key})
+		}
+	}
+	sort.Slice(result.Contents, func(i, j int) bool {
+		return result.Contents[i].Key < result.Contents[j].Key
+	})
+	bz, err := xml.Marshal(result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(bz)
+}
+
+func TestS3_SetAndGetByIndex(t *testing.T) {
+	t.Parallel()
+	backend := &fakeS3{bucket: "blobs", objects: make(map[string][]byte)}
+	srv := httptest.NewServer(backend)
+	t.Cleanup(srv.Close)
+
+	s3, err := archive.NewS3(archive.Config{
+		Backend:     archive.BackendS3,
+		S3Endpoint:  srv.URL,
+		S3Bucket:    "blobs",
+		S3Prefix:    "sidecars/",
+		S3Region:    "us-east-1",
+		S3AccessKey: "access",
+		S3SecretKey: "secret",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s3.Set(10, []byte{0x01}, []byte("a")))
+	require.NoError(t, s3.Set(10, []byte{0x02}, []byte("b")))
+	require.NoError(t, s3.Set(100, []byte{0x01}, []byte("c")))
+	require.Contains(t, backend.objects, "sidecars/10/0x01.ssz")
+
+	values, err := s3.GetByIndex(10)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, values)
+
+	values, err = s3.GetByIndex(11)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestS3_RequestError(t *testing.T) {
+	t.Parallel()
+	backend := &fakeS3{bucket: "blobs", objects: make(map[string][]byte)}
+	srv := httptest.NewServer(backend)
+	t.Cleanup(srv.Close)
+
+	s3, err := archive.NewS3(archive.Config{
+		S3Endpoint:  srv.URL,
+		S3Bucket:    "missing",
+		S3AccessKey: "access",
+		S3SecretKey: "secret",
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, s3.Set(1, []byte{0x01}, nil), archive.ErrS3Request)
+}
+
+func TestNew_Backends(t *testing.T) {
+	t.Parallel()
+	a, err := archive.New(archive.DefaultConfig(), t.TempDir(), nil)
+	require.NoError(t, err)
+	require.Nil(t, a)
+
+	_, err = archive.New(
+		archive.Config{Backend: "tape"}, t.TempDir(), nil,
+	)
+	require.ErrorIs(t, err, archive.ErrUnknownBackend)
+
+	_, err = archive.New(
+		archive.Config{Backend: archive.BackendS3}, t.TempDir(), nil,
+	)
+	require.ErrorIs(t, err, archive.ErrMissingS3Bucket)
+}
@@ -20,7 +20,12 @@
 
 package beacondb
 
-import ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+import (
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+)
 
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
@@ -74,6 +79,60 @@ func (kv *KVStore[
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
+// GetDepositRequestsStartIndex retrieves the index of the first EIP-6110
+// deposit request, which is unset until one is processed.
+func (kv *KVStore[
+	ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	index, err := kv.depositRequestsStartIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	}
+	return index, err
+}
+
+// SetDepositRequestsStartIndex sets the index of the first EIP-6110 deposit
+// request.
+func (kv *KVStore[
+	ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetDepositRequestsStartIndex(
+	index uint64,
+) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
+// GetPendingDeposits retrieves the EIP-6110 deposit requests waiting for the
+// legacy deposits to be processed, in deposit index order.
+func (kv *KVStore[
+	ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetPendingDeposits() ([]*ctypes.Deposit, error) {
+	iter, err := kv.pendingDeposits.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetPendingDeposits replaces the EIP-6110 deposit requests waiting for the
+// legacy deposits to be processed.
+func (kv *KVStore[
+	ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetPendingDeposits(deposits []*ctypes.Deposit) error {
+	if err := kv.pendingDeposits.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	for _, dep := range deposits {
+		if err := kv.pendingDeposits.Set(kv.ctx, dep.Index, dep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetEth1Data retrieves the eth1 data from the beacon state.
 func (kv *KVStore[
 	ExecutionPayloadHeaderT,
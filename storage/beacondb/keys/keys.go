@@ -44,6 +44,8 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	DepositRequestsStartIndexPrefix
+	PendingDepositsPrefix
 )
 
 const (
@@ -70,4 +72,6 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	DepositRequestsStartIndexPrefixHumanReadable        = "DepositRequestsStartIndexPrefix"
+	PendingDepositsPrefixHumanReadable                  = "PendingDepositsPrefix"
 )
@@ -68,6 +68,12 @@ type KVStore[
 	eth1Data sdkcollections.Item[*ctypes.Eth1Data]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositRequestsStartIndex is the index of the first EIP-6110 deposit
+	// request.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
+	// pendingDeposits stores the EIP-6110 deposit requests waiting for the
+	// legacy deposits to be processed, keyed by deposit index.
+	pendingDeposits sdkcollections.Map[uint64, *ctypes.Deposit]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -173,6 +179,21 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingDeposits: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingDepositsPrefix}),
+			keys.PendingDepositsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[*ctypes.Deposit]{},
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+const (
+	// tmpSuffix is the suffix of files that are being written.
+	tmpSuffix = ".tmp"
+	// corruptSuffix is the suffix given to files that failed verification.
+	corruptSuffix = ".corrupt"
+	// checksumMagic prefixes values stored along with their checksum.
+	checksumMagic = "bkc1"
+	// checksumHeaderSize is the size of the header prepended to every value.
+	checksumHeaderSize = len(checksumMagic) + sha256.Size
+)
+
+// encodeValue prepends the checksum header to value.
+func encodeValue(value []byte) []byte {
+	sum := sha256.Sum256(value)
+	bz := make([]byte, 0, checksumHeaderSize+len(value))
+	bz = append(bz, checksumMagic...)
+	bz = append(bz, sum[:]...)
+	return append(bz, value...)
+}
+
+// hasChecksum returns whether bz starts with a checksum header.
+func hasChecksum(bz []byte) bool {
+	return bytes.HasPrefix(bz, []byte(checksumMagic))
+}
+
+// decodeValue strips the checksum header from bz and verifies the value
+// against it. Values without a header are returned unchanged.
+func decodeValue(bz []byte) ([]byte, error) {
+	if !hasChecksum(bz) {
+		return bz, nil
+	}
+	if len(bz) < checksumHeaderSize {
+		return nil, ErrChecksumMismatch
+	}
+	value := bz[checksumHeaderSize:]
+	if sum := sha256.Sum256(value); !bytes.Equal(
+		sum[:], bz[len(checksumMagic):checksumHeaderSize],
+	) {
+		return nil, ErrChecksumMismatch
+	}
+	return value, nil
+}
@@ -23,12 +23,16 @@ package filedb
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/spf13/afero"
 )
 
+// rootPath is the path of the root directory within the filesystem.
+const rootPath = "/"
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -53,9 +57,10 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. It returns ErrChecksumMismatch if the
+// stored value does not match its checksum.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	return db.read(db.pathForKey(key))
 }
 
 // Has returns true if the key exists in the database.
@@ -67,38 +72,164 @@ func (db *DB) Has(key []byte) (bool, error) {
 	return exists, nil
 }
 
-// Set stores the value for a key.
+// Set stores the value for a key. The value is written to a temporary file
+// which is synced and then renamed over the target, so that a crash never
+// leaves a partially written value behind.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	path := db.pathForKey(key)
+	if exists, err := afero.Exists(db.fs, path); err != nil {
 		return err
 	} else if exists {
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+	n, err := db.write(path, value)
+	if err != nil {
 		return err
 	}
+	db.logger.Debug("wrote %d bytes to %s", n, path)
 
-	file, err := db.fs.Create(db.pathForKey(key))
-	if err != nil {
-		return errors.Wrap(err, "failed to create file")
+	return nil
+}
+
+// Delete removes the value for a key.
+func (db *DB) Delete(key []byte) error {
+	return db.fs.RemoveAll(db.pathForKey(key))
+}
+
+// VerifyReport summarizes the outcome of Verify.
+type VerifyReport struct {
+	// Checked is the number of values checked.
+	Checked int
+	// Corrupt are the paths of the values that failed verification.
+	Corrupt []string
+	// Legacy is the number of values stored without a checksum.
+	Legacy int
+	// Rewritten is the number of legacy values rewritten with a checksum.
+	Rewritten int
+	// Vanished are the paths of the values removed while being verified.
+	Vanished []string
+}
+
+// Verify checks the checksum of every value in the database. Values written
+// before checksums were introduced are checked with validateLegacy instead,
+// if not nil, and rewritten along with their checksum if rewriteLegacy is
+// set. Corrupt values are renamed with the corruptSuffix, so that they are
+// reported as missing and stored again. A missing root directory is an empty
+// database, while values removed during the verification are reported.
+func (db *DB) Verify(
+	validateLegacy func(value []byte) error,
+	rewriteLegacy bool,
+) (*VerifyReport, error) {
+	report := &VerifyReport{}
+	vanished := func(path string, err error) error {
+		if path == rootPath || !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		report.Vanished = append(report.Vanished, path)
+		return nil
+	}
+	err := afero.Walk(db.fs, rootPath, func(
+		path string, info os.FileInfo, err error,
+	) error {
+		if err != nil {
+			return vanished(path, err)
+		}
+		if info.IsDir() || !strings.HasSuffix(path, "."+db.extension) {
+			return nil
+		}
+		report.Checked++
+		bz, err := afero.ReadFile(db.fs, path)
+		if err != nil {
+			return vanished(path, err)
+		}
+
+		if hasChecksum(bz) {
+			if _, err = decodeValue(bz); err == nil {
+				return nil
+			}
+		} else {
+			report.Legacy++
+			if validateLegacy != nil {
+				err = validateLegacy(bz)
+			}
+			if err == nil && rewriteLegacy {
+				if _, err = db.write(path, bz); err != nil {
+					return err
+				}
+				report.Rewritten++
+			}
+			if err == nil {
+				return nil
+			}
+		}
+		if err = db.fs.Rename(path, path+corruptSuffix); err != nil {
+			return vanished(path, err)
+		}
+		report.Corrupt = append(report.Corrupt, path)
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return &VerifyReport{}, nil
 	}
-	defer file.Close()
+	return report, err
+}
 
-	n, err := file.Write(value)
+// read reads the value stored at path and verifies its checksum. Values
+// written before checksums were introduced are returned as they are.
+func (db *DB) read(path string) ([]byte, error) {
+	bz, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeValue(bz)
 	if err != nil {
-		return errors.Wrap(err, "failed to write to file")
+		return nil, errors.Wrapf(err, "%s", path)
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
+	return value, nil
+}
 
-	return nil
+// write atomically stores value along with its checksum at path: the value
+// is written to a temporary file which is synced and then renamed over path.
+// It returns the number of bytes written.
+func (db *DB) write(path string, value []byte) (int, error) {
+	dir := filepath.Dir(path)
+	if err := db.fs.MkdirAll(dir, db.dirPerms); err != nil {
+		return 0, err
+	}
+
+	file, err := db.fs.Create(path + tmpSuffix)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create file")
+	}
+	n, err := file.Write(encodeValue(value))
+	if err == nil {
+		err = file.Sync()
+	}
+	if cErr := file.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		_ = db.fs.Remove(path + tmpSuffix)
+		return 0, errors.Wrap(err, "failed to write to file")
+	}
+	if err = db.fs.Rename(path+tmpSuffix, path); err != nil {
+		return 0, errors.Wrap(err, "failed to rename file")
+	}
+	return n, db.syncDir(dir)
 }
 
-// Delete removes the value for a key.
-func (db *DB) Delete(key []byte) error {
-	return db.fs.RemoveAll(db.pathForKey(key))
+// syncDir flushes the directory entry of a renamed file to disk.
+func (db *DB) syncDir(dir string) error {
+	d, err := db.fs.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err = d.Sync(); err != nil {
+		_ = d.Close()
+		return errors.Wrap(err, "failed to sync directory")
+	}
+	return d.Close()
 }
 
 // pathForKey returns the path for a key.
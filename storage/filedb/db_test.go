@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -293,3 +295,117 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_Checksum(t *testing.T) {
+	root := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	require.NoError(t, db.Set([]byte("good"), []byte("value")))
+	require.NoError(t, db.Set([]byte("bad"), []byte("value")))
+	require.NoFileExists(t, filepath.Join(root, "good.txt.tmp"))
+
+	// Values written before checksums were introduced are still readable.
+	require.NoError(t, os.WriteFile(
+		filepath.Join(root, "legacy.txt"), []byte("legacy"), 0600,
+	))
+	value, err := db.Get([]byte("legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), value)
+
+	// Flip the last byte of a stored value.
+	path := filepath.Join(root, "bad.txt")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0600))
+
+	_, err = db.Get([]byte("bad"))
+	require.ErrorIs(t, err, file.ErrChecksumMismatch)
+
+	report, err := db.Verify(nil, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Checked)
+	require.Equal(t, 1, report.Legacy)
+	require.Equal(t, []string{"/bad.txt"}, report.Corrupt)
+
+	// Corrupt values are reported as missing so that they are stored again.
+	exists, err := db.Has([]byte("bad"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	value, err = db.Get([]byte("good"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestDB_VerifyLegacy(t *testing.T) {
+	root := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	for _, key := range []string{"valid", "invalid"} {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, key+".txt"), []byte(key), 0600,
+		))
+	}
+	validate := func(value []byte) error {
+		if string(value) != "valid" {
+			return file.ErrChecksumMismatch
+		}
+		return nil
+	}
+
+	report, err := db.Verify(validate, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Checked)
+	require.Equal(t, 2, report.Legacy)
+	require.Equal(t, 1, report.Rewritten)
+	require.Equal(t, []string{"/invalid.txt"}, report.Corrupt)
+
+	// Rewritten values carry a checksum and are no longer legacy.
+	report, err = db.Verify(validate, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Checked)
+	require.Zero(t, report.Legacy)
+	require.Empty(t, report.Corrupt)
+	value, err := db.Get([]byte("valid"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("valid"), value)
+}
+
+func TestDB_VerifyVanished(t *testing.T) {
+	root := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(filepath.Join(root, "db")),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+
+	// A missing root directory is an empty database.
+	report, err := db.Verify(nil, false)
+	require.NoError(t, err)
+	require.Equal(t, &file.VerifyReport{}, report)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "db"), 0700))
+	for _, key := range []string{"a", "b"} {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, "db", key+".txt"), []byte(key), 0600,
+		))
+	}
+	// Values removed while being verified are reported.
+	remove := func([]byte) error {
+		return os.Remove(filepath.Join(root, "db", "b.txt"))
+	}
+	report, err = db.Verify(remove, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Checked)
+	require.Equal(t, 1, report.Legacy)
+	require.Equal(t, []string{"/b.txt"}, report.Vanished)
+}
@@ -23,12 +23,17 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	db "github.com/berachain/beacon-kit/storage/interfaces"
 	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -60,6 +65,113 @@ func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
 	return db.DB.Get(db.prefix(index, key))
 }
 
+// GetByIndex retrieves every value stored for the given index. It returns no
+// values if nothing is stored for the index.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get by index not supported for this db")
+	}
+	dir := strconv.FormatUint(index, 10)
+	entries, err := afero.ReadDir(f.fs, dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), "."+f.extension) {
+			continue
+		}
+		value, rErr := f.read(filepath.Join(dir, entry.Name()))
+		if rErr != nil {
+			return nil, rErr
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Iterate calls fn with every index, key and value stored in the database,
+// in ascending index order. It stops at the first error returned by fn.
+func (db *RangeDB) Iterate(
+	fn func(index uint64, key, value []byte) error,
+) error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return errors.New("rangedb: iterate not supported for this db")
+	}
+	indexes, err := f.indexes()
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		dir := strconv.FormatUint(index, 10)
+		entries, rErr := afero.ReadDir(f.fs, dir)
+		if rErr != nil {
+			return rErr
+		}
+		for _, entry := range entries {
+			name, found := strings.CutSuffix(entry.Name(), "."+f.extension)
+			if entry.IsDir() || !found {
+				continue
+			}
+			key, dErr := hex.ToBytes(name)
+			if dErr != nil {
+				return dErr
+			}
+			value, vErr := f.read(filepath.Join(dir, entry.Name()))
+			if vErr != nil {
+				return vErr
+			}
+			if err = fn(index, key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// FirstIndex returns the lowest index values are stored for, and false if
+// the database is empty.
+func (db *RangeDB) FirstIndex() (uint64, bool, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return 0, false, errors.New(
+			"rangedb: first index not supported for this db",
+		)
+	}
+	indexes, err := f.indexes()
+	if err != nil || len(indexes) == 0 {
+		return 0, false, err
+	}
+	return indexes[0], true, nil
+}
+
+// indexes returns the indexes of the database directory, in ascending order.
+func (db *DB) indexes() ([]uint64, error) {
+	dirs, err := afero.ReadDir(db.fs, "/")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(dirs))
+	for _, dir := range dirs {
+		index, pErr := strconv.ParseUint(dir.Name(), 10, 64)
+		if !dir.IsDir() || pErr != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
 // Has checks if the given index and key exist in the database.
 // It prefixes the key with the index and a slash before querying the underlying
 // database.
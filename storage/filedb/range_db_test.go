@@ -21,6 +21,7 @@
 package filedb_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -81,6 +82,55 @@ func TestRangeDB(t *testing.T) {
 				require.True(t, exists)
 			},
 		},
+		{
+			name: "GetByIndex",
+			setupFunc: func(rdb *file.RangeDB) error {
+				if err := rdb.Set(
+					2, []byte("testKey1"), []byte("testValue1"),
+				); err != nil {
+					return err
+				}
+				return rdb.Set(2, []byte("testKey2"), []byte("testValue2"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				values, err := rdb.GetByIndex(2)
+				require.NoError(t, err)
+				require.ElementsMatch(t, [][]byte{
+					[]byte("testValue1"), []byte("testValue2"),
+				}, values)
+
+				values, err = rdb.GetByIndex(3)
+				require.NoError(t, err)
+				require.Empty(t, values)
+			},
+		},
+		{
+			name: "Iterate",
+			setupFunc: func(rdb *file.RangeDB) error {
+				if err := rdb.Set(100, []byte{0x02}, []byte("b")); err != nil {
+					return err
+				}
+				return rdb.Set(90, []byte{0x01}, []byte("a"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				// Other cases share the directory, skip their indexes.
+				var seen []string
+				require.NoError(t, rdb.Iterate(
+					func(index uint64, key, value []byte) error {
+						if index < 90 {
+							return nil
+						}
+						seen = append(seen, fmt.Sprintf(
+							"%d/%x=%s", index, key, value,
+						))
+						return nil
+					},
+				))
+				require.Equal(t, []string{"90/01=a", "100/02=b"}, seen)
+			},
+		},
 		{
 			name: "Delete",
 			setupFunc: func(rdb *file.RangeDB) error {
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build pebbledb
+
+package pebbledb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/pebbledb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeDB_Pebble(t *testing.T) {
+	dir := t.TempDir()
+	db, err := pebbledb.Open(dir)
+	require.NoError(t, err)
+	testRangeDB(t, db)
+	require.NoError(t, db.Close())
+
+	// Values survive reopening the database.
+	db, err = pebbledb.Open(dir)
+	require.NoError(t, err)
+	values, err := db.GetByIndex(4)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pebbledb
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// dbName is the name of the database within its directory.
+	dbName = "blobs"
+	// indexSize is the size of the index prefix of every key.
+	indexSize = 8
+)
+
+// Compile-time assertion of prunable interface.
+var _ pruner.Prunable = (*RangeDB)(nil)
+
+// RangeDB is a database that stores versioned data in an embedded key-value
+// store. Keys are prefixed with the big-endian index, so that the values of
+// an index are contiguous and a range of indexes is deleted at once.
+type RangeDB struct {
+	db dbm.DB
+}
+
+// NewRangeDB creates a new RangeDB on top of db.
+func NewRangeDB(db dbm.DB) *RangeDB {
+	return &RangeDB{db: db}
+}
+
+// Open opens the pebble RangeDB in dir, creating it if needed.
+func Open(dir string) (*RangeDB, error) {
+	db, err := dbm.NewDB(dbName, dbm.PebbleDBBackend, dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewRangeDB(db), nil
+}
+
+// Get retrieves the value associated with the given index and key.
+func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
+	return db.db.Get(prefix(index, key))
+}
+
+// Has checks if the given index and key exist in the database.
+func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
+	return db.db.Has(prefix(index, key))
+}
+
+// Set stores the value with the given index and key in the database. The
+// write is synced to disk before returning.
+func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
+	return db.db.SetSync(prefix(index, key), value)
+}
+
+// NewBatch returns a batch of writes to the database.
+func (db *RangeDB) NewBatch() *Batch {
+	return &Batch{batch: db.db.NewBatch()}
+}
+
+// Batch accumulates writes to a RangeDB, applied at once by Write.
+type Batch struct {
+	batch dbm.Batch
+}
+
+// Set adds the value with the given index and key to the batch.
+func (b *Batch) Set(index uint64, key []byte, value []byte) error {
+	return b.batch.Set(prefix(index, key), value)
+}
+
+// Size returns the size of the batch in bytes.
+func (b *Batch) Size() (int, error) {
+	return b.batch.GetByteSize()
+}
+
+// Write applies the batch to the database, synced to disk before returning.
+func (b *Batch) Write() error {
+	return b.batch.WriteSync()
+}
+
+// Close releases the batch. It is a no-op on a closed batch.
+func (b *Batch) Close() error {
+	return b.batch.Close()
+}
+
+// Delete removes the value associated with the given index and key.
+func (db *RangeDB) Delete(index uint64, key []byte) error {
+	return db.db.Delete(prefix(index, key))
+}
+
+// GetByIndex retrieves every value stored for the given index.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	it, err := db.db.Iterator(prefix(index, nil), prefix(index+1, nil))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var values [][]byte
+	for ; it.Valid(); it.Next() {
+		values = append(values, it.Value())
+	}
+	return values, it.Error()
+}
+
+// FirstIndex returns the lowest index values are stored for, and false if
+// the database is empty.
+func (db *RangeDB) FirstIndex() (uint64, bool, error) {
+	it, err := db.db.Iterator(nil, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, false, it.Error()
+	}
+	return binary.BigEndian.Uint64(it.Key()[:indexSize]), true, nil
+}
+
+// Prune removes all values in the given range [start, end) from the db.
+func (db *RangeDB) Prune(start, end uint64) error {
+	if start > end {
+		return fmt.Errorf(
+			"RangeDB Prune start: %d, end: %d: %w",
+			start, end, pruner.ErrInvalidRange,
+		)
+	}
+	from, to := prefix(start, nil), prefix(end, nil)
+
+	// Pebble deletes the whole range with a single tombstone.
+	if p, ok := db.db.(interface{ DB() *pebble.DB }); ok {
+		return p.DB().DeleteRange(from, to, pebble.Sync)
+	}
+	return db.deleteRange(from, to)
+}
+
+// Close closes the underlying database.
+func (db *RangeDB) Close() error {
+	return db.db.Close()
+}
+
+// deleteRange deletes the keys in [from, to) one by one, for backends that
+// do not support range deletion.
+func (db *RangeDB) deleteRange(from, to []byte) error {
+	it, err := db.db.Iterator(from, to)
+	if err != nil {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		if err = batch.Delete(it.Key()); err != nil {
+			_ = it.Close()
+			return err
+		}
+	}
+	if err = it.Error(); err != nil {
+		_ = it.Close()
+		return err
+	}
+	if err = it.Close(); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// prefix prefixes the given key with the big-endian index.
+func prefix(index uint64, key []byte) []byte {
+	bz := make([]byte, indexSize, indexSize+len(key))
+	binary.BigEndian.PutUint64(bz, index)
+	return append(bz, key...)
+}
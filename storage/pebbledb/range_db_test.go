@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pebbledb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/pebbledb"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func testRangeDB(t *testing.T, db *pebbledb.RangeDB) {
+	t.Helper()
+	_, found, err := db.FirstIndex()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	for index := range uint64(5) {
+		require.NoError(t, db.Set(index, []byte{0x02}, []byte{byte(index), 2}))
+		require.NoError(t, db.Set(index, []byte{0x01}, []byte{byte(index), 1}))
+	}
+	require.NoError(t, db.Set(1<<40, []byte{0x01}, []byte("far")))
+
+	has, err := db.Has(3, []byte{0x01})
+	require.NoError(t, err)
+	require.True(t, has)
+	value, err := db.Get(3, []byte{0x02})
+	require.NoError(t, err)
+	require.Equal(t, []byte{3, 2}, value)
+
+	values, err := db.GetByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{2, 1}, {2, 2}}, values)
+
+	require.ErrorIs(t, db.Prune(3, 1), pruner.ErrInvalidRange)
+	require.NoError(t, db.Prune(1, 4))
+	for index, want := range []int{2, 0, 0, 0, 2} {
+		values, err = db.GetByIndex(uint64(index))
+		require.NoError(t, err)
+		require.Len(t, values, want, "index %d", index)
+	}
+	values, err = db.GetByIndex(1 << 40)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("far")}, values)
+
+	require.NoError(t, db.Delete(0, []byte{0x01}))
+	has, err = db.Has(0, []byte{0x01})
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, db.Prune(0, 1))
+	first, found, err := db.FirstIndex()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(4), first)
+}
+
+func TestRangeDB_MemDB(t *testing.T) {
+	db := pebbledb.NewRangeDB(dbm.NewMemDB())
+	testRangeDB(t, db)
+	require.NoError(t, db.Close())
+}
+
+func TestRangeDB_Batch(t *testing.T) {
+	db := pebbledb.NewRangeDB(dbm.NewMemDB())
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set(1, []byte{0x01}, []byte("a")))
+	require.NoError(t, batch.Set(2, []byte{0x01}, []byte("b")))
+	size, err := batch.Size()
+	require.NoError(t, err)
+	require.Positive(t, size)
+
+	// Nothing is written until the batch is.
+	has, err := db.Has(1, []byte{0x01})
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	values, err := db.GetByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("b")}, values)
+	require.NoError(t, db.Close())
+}